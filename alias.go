@@ -10,9 +10,12 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/expr"
 	"github.com/jaypipes/sqlb/core/fn"
+	"github.com/jaypipes/sqlb/core/meta"
 	"github.com/jaypipes/sqlb/core/reflect"
+	"github.com/jaypipes/sqlb/core/scan"
 	"github.com/jaypipes/sqlb/core/types"
 	"github.com/jaypipes/sqlb/internal/builder"
 )
@@ -27,10 +30,28 @@ var PGSQL = types.DialectPostgreSQL
 var TSQL = types.DialectTSQL
 var MSSSQL = types.DialectTSQL
 var MicrosoftSQLServer = types.DialectTSQL
+var SQLite = types.DialectSQLite
+
+// DialectCustom is the lowest identifier for custom dialects registered
+// using RegisterDialect
+var DialectCustom = types.DialectCustom
+
+// RegisterDialect registers a custom dialect.Dialect with the supplied
+// identifier. Custom dialects typically embed one of the built-in dialects in
+// `core/dialect` and override the methods that differ.
+var RegisterDialect = dialect.Register
+
+// RegisterDriver maps a database/sql driver type name, e.g. "*pq.Driver", to
+// a Dialect identifier so that Reflect can detect the Dialect of a connection
+var RegisterDriver = dialect.RegisterDriver
 
 // WithDialect informs sqlb of the Dialect
 var WithDialect = types.WithDialect
 
+// WithDialectVersion informs sqlb of the version of the RDBMS, e.g. "3.38.5",
+// allowing sqlb to avoid SQL syntax that the version does not support
+var WithDialectVersion = types.WithDialectVersion
+
 // WithFormatSeparateClauseWith instructs sqlb to use a supplied string as the
 // separator between clauses
 var WithFormatSeparateClauseWith = types.WithFormatSeparateClauseWith
@@ -39,6 +60,47 @@ var WithFormatSeparateClauseWith = types.WithFormatSeparateClauseWith
 // the resulting SQL string
 var WithFormatPrefixWith = types.WithFormatPrefixWith
 
+// WithFormatPretty instructs sqlb to put each clause, select-list item, join,
+// predicate conjunct and subquery on its own line, indenting each level of
+// nesting with the supplied string
+var WithFormatPretty = types.WithFormatPretty
+
+// KeywordCase determines the case that SQL keywords are output in
+type KeywordCase = types.KeywordCase
+
+var KeywordCaseDefault = types.KeywordCaseDefault
+var KeywordCaseUpper = types.KeywordCaseUpper
+var KeywordCaseLower = types.KeywordCaseLower
+
+// WithFormatKeywordCase instructs sqlb to output SQL keywords in the supplied
+// case
+var WithFormatKeywordCase = types.WithFormatKeywordCase
+
+// WithInlineArgs instructs sqlb to output query args as SQL literals in place
+// of their placeholders. It is for debugging and logging only: never execute
+// SQL built with it. See Interpolate.
+var WithInlineArgs = types.WithInlineArgs
+
+// WithSchemas instructs Reflect to reflect the tables in the supplied schemas
+// instead of only the tables in the default schema
+var WithSchemas = types.WithSchemas
+
+// WithAllSchemas instructs Reflect to reflect the tables in all schemas except
+// the RDBMS's own system schemas
+var WithAllSchemas = types.WithAllSchemas
+
+// QuoteMode determines when identifiers are delimited with the Dialect's
+// quote characters
+type QuoteMode = types.QuoteMode
+
+var QuoteWhenNeeded = types.QuoteWhenNeeded
+var QuoteAlways = types.QuoteAlways
+var QuoteNever = types.QuoteNever
+
+// WithQuoteMode instructs sqlb when to delimit identifiers with the Dialect's
+// quote characters. The default is QuoteWhenNeeded.
+var WithQuoteMode = types.WithQuoteMode
+
 // Reflect examines the supplied database connection and discovers Table
 // definitions within that connection's associated database, returning a
 // pointer to a Meta struct with the discovered information.
@@ -273,6 +335,30 @@ var NoTargetTable = types.NoTargetTable
 var NoValues = types.NoValues
 var UnknownColumn = types.UnknownColumn
 var TableRequired = types.TableRequired
+var UnmatchedColumn = types.UnmatchedColumn
+var AmbiguousColumn = types.AmbiguousColumn
+var InvalidScanDestination = types.InvalidScanDestination
+var NoForeignKey = types.NoForeignKey
+var AmbiguousForeignKey = types.AmbiguousForeignKey
+var ReadOnlyRelation = types.ReadOnlyRelation
+var FunctionNotSupported = types.FunctionNotSupported
+var FeatureNotSupported = types.FeatureNotSupported
+var UnsupportedBuildTarget = types.UnsupportedBuildTarget
+var UnknownRelationReference = types.UnknownRelationReference
+var EmptyInList = types.EmptyInList
+var LimitWithoutOrderBy = types.LimitWithoutOrderBy
+var UnsupportedInlineArg = types.UnsupportedInlineArg
+var MissingParam = types.MissingParam
+var UnknownParam = types.UnknownParam
+var InvalidBindSource = types.InvalidBindSource
+
+// TruncateRestartIdentity instructs Table.Truncate() to reset any
+// identity/sequence columns owned by the table.
+var TruncateRestartIdentity = meta.TruncateRestartIdentity
+
+// TruncateCascade instructs Table.Truncate() to also truncate any tables that
+// have foreign key references to the table.
+var TruncateCascade = meta.TruncateCascade
 
 // Max returns a AggregateFunction that can be passed to a Select function to
 // create a MAX(<value expression>) SQL function.  The supplied argument should
@@ -343,6 +429,16 @@ var Upper = fn.Upper
 // to a character value expression.
 var Lower = fn.Lower
 
+// Concat returns a ConcatFunction that produces the concatenation of the
+// supplied character values that can be passed to sqlb constructs and
+// functions like Select()
+//
+// Each argument must be coercible to a character factor and there must be at
+// least two arguments. The concatenation is output using the "||" operator
+// for PostgreSQL and SQLite, the CONCAT() SQL function for MySQL and the "+"
+// operator for T-SQL.
+var Concat = fn.Concat
+
 // Convert returns a TranscodingFunction that produces a CONVERT() SQL
 // function that can be passed to sqlb constructs and functions like Select()
 //
@@ -537,41 +633,195 @@ var ConcatWs = function.ConcatWs
 var Now = function.Now
 */
 
-// Query accepts a `database/sql` `DB` handle and a queryable object (returned
-// from Select(), Insert(), Update(), or Delete()) and calls the
-// `databases/sql.DB.Query` method on the SQL string produced by that queryable
-// object.
+// Executor is something that can execute SQL statements, such as a
+// `*sql.DB`, `*sql.Tx` or `*sql.Conn`
+type Executor = types.Executor
+
+// Build accepts a target (a `*meta.Table`, the object returned from Select(),
+// Insert(), Update() or Delete(), a derived table or a `core/grammar`
+// statement) and returns the SQL string and query args produced by that
+// target.
+//
+// An error is returned if the target is not something sqlb knows how to
+// build, cannot be output in the Dialect, or would not be valid SQL, e.g.
+// because it refers to a table that is not in its FROM clause or contains an
+// IN predicate with no values.
+func Build(
+	target interface{},
+	opts ...types.Option,
+) (string, []interface{}, error) {
+	b := builder.Acquire(opts...)
+	defer b.Release()
+	return b.Build(target)
+}
+
+// Interpolate returns the SQL string produced by the supplied target with
+// each query arg output as a SQL literal in place of its placeholder, e.g.
+// for logging the exact SQL of a failing query.
+//
+// Interpolate is for debugging and logging only. NEVER execute the SQL it
+// returns: use Query or Exec, which pass the query args to the database
+// driver separately.
+var Interpolate = builder.Interpolate
+
+// Fingerprint returns a stable hash and a normalized SQL string describing
+// the shape of the supplied target, for tagging metrics by query. The
+// fingerprint does not depend on the values of query args, the length of IN
+// lists or the order in which the FROM clause relations were supplied.
+var Fingerprint = builder.Fingerprint
+
+// Param returns a named parameter that can be used anywhere a value is
+// accepted, e.g. `Equal(users.C("id"), Param("id"))`. The values of named
+// parameters are supplied to a Template returned by Compile. Build returns
+// an error wrapping MissingParam for a statement with named parameters.
+var Param = expr.Param
+
+// Template is a SQL statement that has been built once and produces the
+// query args to execute it with from the values of its named parameters
+type Template = builder.Template
+
+// Compile builds the supplied target (the object returned from Select(),
+// Insert(), Update() or Delete()) once and returns a Template whose Args and
+// Bind methods produce the positional query args for the Template's SQL
+// string from the values of the named parameters created with Param. An
+// error is returned if the target cannot be built.
+var Compile = builder.Compile
+
+// Query accepts an Executor (a `database/sql` `DB`, `Tx` or `Conn`) and a
+// queryable object (returned from Select(), Insert(), Update(), or Delete())
+// and calls the Executor's `QueryContext` method on the SQL string produced
+// by that queryable object.
 func Query(
-	db *sql.DB,
+	db Executor,
 	target interface{},
 	opts ...types.Option,
 ) (*sql.Rows, error) {
 	return QueryContext(context.TODO(), db, target, opts...)
 }
 
-// QueryContext accepts a `database/sql` `DB` handle and a queryable object
-// (returned from Select(), Insert(), Update(), or Delete()) and calls the
-// `databases/sql.DB.QueryContext` method on the SQL string produced by that
-// queryable object.
+// QueryContext accepts an Executor (a `database/sql` `DB`, `Tx` or `Conn`)
+// and a queryable object (returned from Select(), Insert(), Update(), or
+// Delete()) and calls the Executor's `QueryContext` method on the SQL string
+// produced by that queryable object.
 func QueryContext(
 	ctx context.Context,
-	db *sql.DB,
+	db Executor,
 	target interface{},
 	opts ...types.Option,
 ) (*sql.Rows, error) {
-	b := builder.New(opts...)
-	var el interface{}
-	switch target := target.(type) {
-	case *expr.Selection:
-		el = target.Query()
-	default:
-		el = target
+	qs, qargs, err := Build(target, opts...)
+	if err != nil {
+		return nil, err
 	}
-
-	qs, qargs := b.StringArgs(el)
 	return db.QueryContext(ctx, qs, qargs...)
 }
 
+// QueryRow accepts an Executor (a `database/sql` `DB`, `Tx` or `Conn`) and a
+// queryable object (returned from Select(), Insert(), Update(), or Delete())
+// and calls the Executor's `QueryRowContext` method on the SQL string
+// produced by that queryable object.
+func QueryRow(
+	db Executor,
+	target interface{},
+	opts ...types.Option,
+) (*sql.Row, error) {
+	return QueryRowContext(context.TODO(), db, target, opts...)
+}
+
+// QueryRowContext accepts an Executor (a `database/sql` `DB`, `Tx` or `Conn`)
+// and a queryable object (returned from Select(), Insert(), Update(), or
+// Delete()) and calls the Executor's `QueryRowContext` method on the SQL
+// string produced by that queryable object.
+//
+// Since a `*sql.Row` cannot be constructed with an error, QueryRowContext
+// returns an error, and no row, if the queryable object cannot be built. See
+// Build. Errors executing the query are returned by the row's Scan method.
+func QueryRowContext(
+	ctx context.Context,
+	db Executor,
+	target interface{},
+	opts ...types.Option,
+) (*sql.Row, error) {
+	qs, qargs, err := Build(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryRowContext(ctx, qs, qargs...), nil
+}
+
+// Exec accepts an Executor (a `database/sql` `DB`, `Tx` or `Conn`) and an
+// executable object (returned from Insert(), Update(), Delete() or one of the
+// `meta.Table` statement methods) and calls the Executor's `ExecContext`
+// method on the SQL string produced by that executable object.
+func Exec(
+	db Executor,
+	target interface{},
+	opts ...types.Option,
+) (sql.Result, error) {
+	return ExecContext(context.TODO(), db, target, opts...)
+}
+
+// ExecContext accepts an Executor (a `database/sql` `DB`, `Tx` or `Conn`)
+// and an executable object (returned from Insert(), Update(), Delete() or
+// one of the `meta.Table` statement methods) and calls the Executor's
+// `ExecContext` method on the SQL string produced by that executable object.
+func ExecContext(
+	ctx context.Context,
+	db Executor,
+	target interface{},
+	opts ...types.Option,
+) (sql.Result, error) {
+	qs, qargs, err := Build(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, qs, qargs...)
+}
+
+// Scan scans the current row of the supplied `*sql.Rows` into the struct
+// pointed to by dest, matching result columns to struct fields by name. See
+// the `core/scan` package for details on how columns are matched to fields.
+var Scan = scan.Row
+
+// All executes the supplied queryable object (returned from Select(), or from
+// Insert(), Update() or Delete() with a RETURNING clause) and scans all
+// result rows into a slice of T, which must be a struct type or a pointer to
+// a struct type. See the `core/scan` package for details on how columns are
+// matched to fields.
+func All[T any](
+	ctx context.Context,
+	db Executor,
+	target interface{},
+	opts ...types.Option,
+) ([]T, error) {
+	rows, err := QueryContext(ctx, db, target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scan.All[T](rows)
+}
+
+// One executes the supplied queryable object (returned from Select(), or from
+// Insert(), Update() or Delete() with a RETURNING clause) and scans the first
+// result row into a T, which must be a struct type or a pointer to a struct
+// type. If there are no result rows, `sql.ErrNoRows` is returned. See the
+// `core/scan` package for details on how columns are matched to fields.
+func One[T any](
+	ctx context.Context,
+	db Executor,
+	target interface{},
+	opts ...types.Option,
+) (T, error) {
+	rows, err := QueryContext(ctx, db, target, opts...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer rows.Close()
+	return scan.One[T](rows)
+}
+
 // Select returns a QuerySpecification that produces a SELECT SQL statement for
 // one or more items. Items can be a Table, a Column, a Function, another
 // SELECT query, or even a literal value.
@@ -592,3 +842,58 @@ var Select = expr.Select
 // If sqlb cannot compile the supplied arguments into a valid SELECT SQL query,
 // SelectE returns an error.
 var SelectE = expr.SelectE
+
+// Insert returns an InsertStatement that produces an INSERT SQL statement for
+// the supplied Table and map, keyed by column name, of values to insert.
+//
+// Insert panics if sqlb cannot compile the supplied arguments into a valid
+// INSERT SQL statement. This is intentional, as we want compile-time failures
+// for invalid SQL construction and we want Insert() to be chainable with
+// other InsertStatement methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `InsertE` function which returns a checkable `error` object.
+var Insert = expr.Insert
+
+// InsertE returns an InsertStatement that produces an INSERT SQL statement
+// for the supplied Table and map, keyed by column name, of values to insert.
+//
+// If sqlb cannot compile the supplied arguments into a valid INSERT SQL
+// statement, InsertE returns an error.
+var InsertE = expr.InsertE
+
+// Update returns an UpdateStatement that produces an UPDATE SQL statement for
+// the supplied Table and map, keyed by column name, of values to update.
+//
+// Update panics if sqlb cannot compile the supplied arguments into a valid
+// UPDATE SQL statement. This is intentional, as we want compile-time failures
+// for invalid SQL construction and we want Update() to be chainable with
+// other UpdateStatement methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `UpdateE` function which returns a checkable `error` object.
+var Update = expr.Update
+
+// UpdateE returns an UpdateStatement that produces an UPDATE SQL statement
+// for the supplied Table and map, keyed by column name, of values to update.
+//
+// If sqlb cannot compile the supplied arguments into a valid UPDATE SQL
+// statement, UpdateE returns an error.
+var UpdateE = expr.UpdateE
+
+// Delete returns a DeleteStatement that produces a DELETE SQL statement for
+// the supplied Table.
+//
+// Delete panics if the supplied Table is nil. This is intentional, as we want
+// compile-time failures for invalid SQL construction and we want Delete() to
+// be chainable with other DeleteStatement methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `DeleteE` function which returns a checkable `error` object.
+var Delete = expr.Delete
+
+// DeleteE returns a DeleteStatement that produces a DELETE SQL statement for
+// the supplied Table.
+//
+// If the supplied Table is nil, DeleteE returns an error.
+var DeleteE = expr.DeleteE
@@ -0,0 +1,371 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package sqlb_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/jaypipes/sqlb"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder is an Executor that records the SQL string and arguments it was
+// asked to execute
+type recorder struct {
+	qs    string
+	qargs []any
+}
+
+func (r *recorder) ExecContext(
+	_ context.Context,
+	qs string,
+	qargs ...any,
+) (sql.Result, error) {
+	r.qs, r.qargs = qs, qargs
+	return nil, nil
+}
+
+func (r *recorder) QueryContext(
+	_ context.Context,
+	qs string,
+	qargs ...any,
+) (*sql.Rows, error) {
+	r.qs, r.qargs = qs, qargs
+	return nil, nil
+}
+
+func (r *recorder) QueryRowContext(
+	_ context.Context,
+	qs string,
+	qargs ...any,
+) *sql.Row {
+	r.qs, r.qargs = qs, qargs
+	return nil
+}
+
+func TestExecutor(t *testing.T) {
+	users := testutil.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	tests := []struct {
+		name  string
+		run   func(sqlb.Executor)
+		qs    string
+		qargs []any
+	}{
+		{
+			name: "Exec Insert",
+			run: func(db sqlb.Executor) {
+				sqlb.Exec(db, sqlb.Insert(users, map[string]any{"id": 1}))
+			},
+			qs:    "INSERT INTO users (id) VALUES (?)",
+			qargs: []any{1},
+		},
+		{
+			name: "Exec Table.DeleteAll",
+			run: func(db sqlb.Executor) {
+				sqlb.Exec(db, users.DeleteAll())
+			},
+			qs: "DELETE FROM users",
+		},
+		{
+			name: "Exec Update",
+			run: func(db sqlb.Executor) {
+				sqlb.Exec(
+					db,
+					sqlb.Update(users, map[string]any{"name": "foo"}).
+						Where(sqlb.Equal(colUserId, 1)),
+				)
+			},
+			qs:    "UPDATE users SET name = ? WHERE users.id = ?",
+			qargs: []any{"foo", 1},
+		},
+		{
+			name: "Query Select",
+			run: func(db sqlb.Executor) {
+				sqlb.Query(db, sqlb.Select(colUserName))
+			},
+			qs: "SELECT users.name FROM users",
+		},
+		{
+			name: "QueryRow Select",
+			run: func(db sqlb.Executor) {
+				sqlb.QueryRow(
+					db,
+					sqlb.Select(colUserName).Where(sqlb.Equal(colUserId, 1)),
+				)
+			},
+			qs:    "SELECT users.name FROM users WHERE users.id = ?",
+			qargs: []any{1},
+		},
+		{
+			name: "Query Delete Returning",
+			run: func(db sqlb.Executor) {
+				sqlb.Query(
+					db,
+					sqlb.Delete(users).
+						Where(sqlb.Equal(colUserId, 1)).
+						Returning(colUserName),
+					sqlb.WithDialect(sqlb.PostgreSQL),
+				)
+			},
+			qs:    "DELETE FROM users WHERE users.id = $1 RETURNING name",
+			qargs: []any{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			r := &recorder{}
+			tt.run(r)
+			assert.Equal(tt.qs, r.qs)
+			assert.Equal(len(tt.qargs), len(r.qargs))
+			if len(tt.qargs) > 0 {
+				assert.Equal(tt.qargs, r.qargs)
+			}
+		})
+	}
+}
+
+func TestQueryRowBuildError(t *testing.T) {
+	assert := assert.New(t)
+	users := testutil.T("users")
+
+	// A target that cannot be built is reported as an error without
+	// executing anything
+	r := &recorder{}
+	row, err := sqlb.QueryRow(r, sqlb.Select(users.C("name")).Where(
+		sqlb.In(users.C("id")),
+	))
+	assert.ErrorIs(err, sqlb.EmptyInList)
+	assert.Nil(row)
+	assert.Empty(r.qs)
+}
+
+func TestBuild(t *testing.T) {
+	users := testutil.T("users")
+	articles := testutil.T("articles")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	tests := []struct {
+		name   string
+		target any
+		opts   []types.Option
+		qs     string
+		qargs  []any
+	}{
+		{
+			name:   "Table",
+			target: users,
+			qs:     "SELECT users.created_on, users.id, users.name FROM users",
+		},
+		{
+			name:   "Relation",
+			target: sqlb.Select(colUserName).As("u"),
+			qs:     "SELECT users.name FROM users",
+		},
+		{
+			name: "Selection",
+			target: sqlb.Select(colUserName).
+				Where(sqlb.In(colUserId, 1, 2)).
+				OrderBy(colUserName).
+				Limit(10),
+			qs:    "SELECT users.name FROM users WHERE users.id IN(?, ?) ORDER BY users.name LIMIT ?",
+			qargs: []any{1, 2, 10},
+		},
+		{
+			name:   "Selection from derived table",
+			target: sqlb.Select(sqlb.Select(colUserName).As("u")),
+			qs:     "SELECT u.name FROM (SELECT users.name FROM users) AS u",
+		},
+		{
+			name: "Join",
+			target: sqlb.Select(colUserName, articles.C("id")).
+				Join(articles, sqlb.Equal(articles.C("author"), colUserId)),
+			qs: "SELECT users.name, articles.id FROM users JOIN articles ON articles.author = users.id",
+		},
+		{
+			name:   "Insert",
+			target: sqlb.Insert(users, map[string]any{"id": 1}),
+			qs:     "INSERT INTO users (id) VALUES (?)",
+			qargs:  []any{1},
+		},
+		{
+			name:   "Table.DeleteAll",
+			target: users.DeleteAll(),
+			qs:     "DELETE FROM users",
+		},
+		{
+			name: "Update",
+			target: sqlb.Update(users, map[string]any{"name": "foo"}).
+				Where(sqlb.Equal(colUserId, 1)),
+			qs:    "UPDATE users SET name = ? WHERE users.id = ?",
+			qargs: []any{"foo", 1},
+		},
+		{
+			name: "T-SQL TOP with ORDER BY",
+			target: sqlb.Select(colUserName).
+				OrderBy(colUserName).
+				Limit(10),
+			opts:  []types.Option{sqlb.WithDialect(sqlb.TSQL)},
+			qs:    "SELECT TOP (@p1) users.name FROM users ORDER BY users.name",
+			qargs: []any{10},
+		},
+		{
+			name:   "T-SQL TOP without ORDER BY",
+			target: sqlb.Select(colUserName).Limit(10),
+			opts:   []types.Option{sqlb.WithDialect(sqlb.TSQL)},
+			qs:     "SELECT TOP (@p1) users.name FROM users",
+			qargs:  []any{10},
+		},
+		{
+			name: "aliased table referred to by its alias",
+			target: sqlb.Select(users.As("u").C("name")).
+				Where(sqlb.Equal(users.As("u").C("id"), 1)),
+			qs:    "SELECT u.name FROM users AS u WHERE u.id = ?",
+			qargs: []any{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			qs, qargs, err := sqlb.Build(tt.target, tt.opts...)
+			require.Nil(t, err)
+			assert.Equal(tt.qs, qs)
+			assert.Equal(len(tt.qargs), len(qargs))
+			if len(tt.qargs) > 0 {
+				assert.Equal(tt.qargs, qargs)
+			}
+		})
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	users := testutil.T("users")
+	articles := testutil.T("articles")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	tests := []struct {
+		name   string
+		target any
+		opts   []types.Option
+		err    error
+	}{
+		{
+			name:   "unsupported target",
+			target: "SELECT 1",
+			err:    sqlb.UnsupportedBuildTarget,
+		},
+		{
+			name:   "nil target",
+			target: nil,
+			err:    sqlb.UnsupportedBuildTarget,
+		},
+		{
+			name: "unknown relation in WHERE",
+			target: sqlb.Select(colUserName).
+				Where(sqlb.Equal(articles.C("author"), 1)),
+			err: sqlb.UnknownRelationReference,
+		},
+		{
+			name: "unknown relation in UPDATE",
+			target: sqlb.Update(users, map[string]any{"name": "foo"}).
+				Where(sqlb.Equal(articles.C("id"), 1)),
+			err: sqlb.UnknownRelationReference,
+		},
+		{
+			name:   "empty IN list",
+			target: sqlb.Select(colUserName).Where(sqlb.In(colUserId)),
+			err:    sqlb.EmptyInList,
+		},
+		{
+			name:   "T-SQL LIMIT with OFFSET without ORDER BY",
+			target: sqlb.Select(colUserName).LimitWithOffset(10, 20),
+			opts:   []types.Option{sqlb.WithDialect(sqlb.TSQL)},
+			err:    sqlb.LimitWithoutOrderBy,
+		},
+		{
+			name: "aliased table referred to by its name",
+			target: sqlb.Select(users.As("u").C("name")).
+				Where(sqlb.Equal(colUserId, 1)),
+			err: sqlb.UnknownRelationReference,
+		},
+		{
+			name: "named parameter outside a Template",
+			target: sqlb.Select(colUserName).
+				Where(sqlb.Equal(colUserId, sqlb.Param("id"))),
+			err: sqlb.MissingParam,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qs, qargs, err := sqlb.Build(tt.target, tt.opts...)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, "", qs)
+			assert.Nil(t, qargs)
+		})
+	}
+}
+
+func TestQueryBuildError(t *testing.T) {
+	users := testutil.T("users")
+
+	r := &recorder{}
+	_, err := sqlb.Query(r, users.C("name"))
+	assert.ErrorIs(t, err, sqlb.UnsupportedBuildTarget)
+	assert.Equal(t, "", r.qs)
+}
+
+func TestAllOne(t *testing.T) {
+	assert := assert.New(t)
+
+	type user struct {
+		ID   int64
+		Name string `db:"author"`
+	}
+
+	users := testutil.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	d := &testutil.FakeDriver{
+		Result: testutil.FakeResult{
+			Columns: []string{"id", "author"},
+			Rows: [][]driver.Value{
+				{int64(1), "foo"},
+				{int64(2), "bar"},
+			},
+		},
+	}
+	db := testutil.NewFakeDB(d)
+	sel := sqlb.Select(colUserId, colUserName.As("author"))
+
+	all, err := sqlb.All[user](context.TODO(), db, sel)
+	require.Nil(t, err)
+	assert.Equal([]user{{1, "foo"}, {2, "bar"}}, all)
+
+	one, err := sqlb.One[*user](context.TODO(), db, sel)
+	require.Nil(t, err)
+	assert.Equal(&user{1, "foo"}, one)
+
+	assert.Equal(
+		[]string{
+			"SELECT users.id, users.name AS author FROM users",
+			"SELECT users.id, users.name AS author FROM users",
+		},
+		d.Executed,
+	)
+}
@@ -0,0 +1,130 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+)
+
+// ANSI is the Dialect used when the RDBMS is unknown. It outputs ANSI SQL
+// and is embedded by the other built-in Dialects, which override the methods
+// that differ.
+type ANSI struct{}
+
+// Name returns the name of the dialect
+func (ANSI) Name() string {
+	return "ANSI"
+}
+
+// Placeholder returns the query argument marker for the query argument at the
+// supplied zero-based position
+func (ANSI) Placeholder(position int) string {
+	return symbol.QuestionMark
+}
+
+// IdentifierQuotes returns the opening and closing characters that delimit
+// an identifier
+func (ANSI) IdentifierQuotes() (string, string) {
+	return symbol.DoubleQuote, symbol.DoubleQuote
+}
+
+// IsReserved returns true if the supplied word is an ANSI SQL:2003 reserved
+// word
+func (ANSI) IsReserved(word string) bool {
+	return symbol.IsANSI2003Reserved(word)
+}
+
+// FoldsToLowerCase returns true if undelimited identifiers are folded to
+// lower case
+func (ANSI) FoldsToLowerCase() bool {
+	return false
+}
+
+// LimitStyle returns how the row count and offset of a query are output
+func (ANSI) LimitStyle() LimitStyle {
+	return LimitStyleLimitOffset
+}
+
+// FunctionName returns the dialect's name for the supplied ANSI SQL function
+// name
+func (ANSI) FunctionName(name string) string {
+	return name
+}
+
+// FunctionSyntax returns the syntax used to output the supplied ANSI SQL
+// function
+func (ANSI) FunctionSyntax(name string) FunctionSyntax {
+	return FunctionSyntaxStandard
+}
+
+// BooleanLiteral returns the literal representing the supplied boolean value
+func (ANSI) BooleanLiteral(value bool) string {
+	if value {
+		return symbol.True
+	}
+	return symbol.False
+}
+
+// StringLiteral returns the supplied string as a character string literal,
+// doubling any single quotes
+func (ANSI) StringLiteral(value string) string {
+	return quoteString(value, false)
+}
+
+// BinaryLiteral returns the supplied bytes as a hexadecimal binary string
+// literal, e.g. X'DEADBEEF'
+func (ANSI) BinaryLiteral(value []byte) string {
+	return "X'" + strings.ToUpper(hex.EncodeToString(value)) + "'"
+}
+
+// TimestampLiteral returns the supplied time as a TIMESTAMP literal, e.g.
+// TIMESTAMP '2006-01-02 15:04:05.999999'
+func (ANSI) TimestampLiteral(value time.Time) string {
+	return symbol.Timestamp + symbol.Space + quoteString(value.Format(timestampLayout), false)
+}
+
+// ConcatenationOperator returns the operator that concatenates strings
+func (ANSI) ConcatenationOperator() string {
+	return symbol.ConcatenationOperator
+}
+
+// RowIdentifier returns the name of the pseudo-column that uniquely
+// identifies a row in a table
+func (ANSI) RowIdentifier() string {
+	return ""
+}
+
+// UpdateJoinStyle returns how an UPDATE statement joining the target table to
+// other tables is output
+func (ANSI) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleJoin
+}
+
+// DeleteJoinStyle returns how a DELETE statement joining the target table to
+// other tables is output
+func (ANSI) DeleteJoinStyle() DeleteJoinStyle {
+	return DeleteJoinStyleFrom
+}
+
+// Supports returns true if the supplied Feature is supported
+func (ANSI) Supports(feature Feature, version string) bool {
+	switch feature {
+	case FeatureOutputClause, FeatureTruncateOptions, FeatureDMLOrderByLimit:
+		return false
+	}
+	return true
+}
+
+// Reflection returns nil, since the catalog of an unknown RDBMS cannot be
+// reflected
+func (ANSI) Reflection() *Reflection {
+	return nil
+}
@@ -0,0 +1,210 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// Dialect describes how SQL is written for, and how the catalog is
+// reflected from, a particular RDBMS.
+//
+// sqlb registers a Dialect for each of MySQL, PostgreSQL, SQLite and T-SQL.
+// Custom dialects, for example for CockroachDB or MariaDB, can embed one of
+// the built-in Dialect structs, override the methods that differ and be
+// registered using Register.
+type Dialect interface {
+	// Name returns the name of the dialect, e.g. "PostgreSQL"
+	Name() string
+	// Placeholder returns the query argument marker for the query argument
+	// at the supplied zero-based position, e.g. "?", "$1" or "@p1"
+	Placeholder(position int) string
+	// IdentifierQuotes returns the opening and closing characters that
+	// delimit an identifier
+	IdentifierQuotes() (string, string)
+	// IsReserved returns true if the supplied word cannot be used as an
+	// undelimited identifier
+	IsReserved(word string) bool
+	// FoldsToLowerCase returns true if undelimited identifiers are folded to
+	// lower case, which means identifiers containing upper-case letters must
+	// be delimited
+	FoldsToLowerCase() bool
+	// LimitStyle returns how the row count and offset of a query are output
+	LimitStyle() LimitStyle
+	// FunctionName returns the dialect's name for the supplied ANSI SQL
+	// function name, e.g. "LEN" for "CHAR_LENGTH" in T-SQL
+	FunctionName(name string) string
+	// FunctionSyntax returns the syntax used to output the supplied ANSI SQL
+	// function, or FunctionSyntaxUnsupported if the dialect has no
+	// equivalent of the function
+	FunctionSyntax(name string) FunctionSyntax
+	// BooleanLiteral returns the literal representing the supplied boolean
+	// value
+	BooleanLiteral(value bool) string
+	// StringLiteral returns the supplied string as a quoted and escaped
+	// character string literal
+	StringLiteral(value string) string
+	// BinaryLiteral returns the literal representing the supplied bytes
+	BinaryLiteral(value []byte) string
+	// TimestampLiteral returns the literal representing the supplied time
+	TimestampLiteral(value time.Time) string
+	// ConcatenationOperator returns the operator that concatenates strings,
+	// or an empty string if strings are concatenated using the CONCAT()
+	// function
+	ConcatenationOperator() string
+	// RowIdentifier returns the name of the pseudo-column that uniquely
+	// identifies a row in a table, e.g. "ctid", or an empty string if there is
+	// no such pseudo-column
+	RowIdentifier() string
+	// UpdateJoinStyle returns how an UPDATE statement joining the target
+	// table to other tables is output
+	UpdateJoinStyle() UpdateJoinStyle
+	// DeleteJoinStyle returns how a DELETE statement joining the target
+	// table to other tables is output
+	DeleteJoinStyle() DeleteJoinStyle
+	// Supports returns true if the supplied version of the RDBMS supports
+	// the supplied Feature. The version may be empty if unknown.
+	Supports(feature Feature, version string) bool
+	// Reflection returns the queries used to reflect the database's catalog,
+	// or nil if reflection is not supported
+	Reflection() *Reflection
+}
+
+// Feature is a SQL feature that not all dialects support
+type Feature int
+
+const (
+	// FeatureReturning is the RETURNING clause of INSERT, UPDATE and DELETE
+	// statements
+	FeatureReturning Feature = iota
+	// FeatureOutputClause is the T-SQL OUTPUT clause of INSERT, UPDATE and
+	// DELETE statements
+	FeatureOutputClause
+	// FeatureRightJoin is RIGHT [OUTER] JOIN
+	FeatureRightJoin
+	// FeatureFullJoin is FULL [OUTER] JOIN
+	FeatureFullJoin
+	// FeatureTruncate is the TRUNCATE TABLE statement
+	FeatureTruncate
+	// FeatureTruncateOptions is the RESTART IDENTITY and CASCADE options of
+	// the TRUNCATE TABLE statement
+	FeatureTruncateOptions
+	// FeatureDMLOrderByLimit is the ORDER BY and LIMIT clauses of UPDATE and
+	// DELETE statements
+	FeatureDMLOrderByLimit
+	// FeatureDatetimePrecision is the fractional seconds precision of
+	// CURRENT_TIME, CURRENT_TIMESTAMP, LOCALTIME and LOCALTIMESTAMP
+	FeatureDatetimePrecision
+	// FeatureUpdateFrom is the FROM clause of a multi-table UPDATE statement
+	// in dialects whose UpdateJoinStyle is UpdateJoinStyleFrom
+	FeatureUpdateFrom
+	// FeatureTrimSpecification is the LEADING and TRAILING specifications of
+	// the standard TRIM function syntax
+	FeatureTrimSpecification
+)
+
+// LimitStyle determines how the row count and offset of a query are output
+type LimitStyle int
+
+const (
+	// LimitStyleLimitOffset outputs LIMIT n [OFFSET m]
+	LimitStyleLimitOffset LimitStyle = iota
+	// LimitStyleTopOffsetFetch outputs SELECT TOP (n) without an offset and
+	// OFFSET m ROWS FETCH NEXT n ROWS ONLY with an offset
+	LimitStyleTopOffsetFetch
+)
+
+// UpdateJoinStyle determines how an UPDATE statement that joins the target
+// table to other tables is output
+type UpdateJoinStyle int
+
+const (
+	// UpdateJoinStyleJoin outputs UPDATE t JOIN o ON ... SET ...
+	UpdateJoinStyleJoin UpdateJoinStyle = iota
+	// UpdateJoinStyleFrom outputs UPDATE t SET ... FROM o WHERE ...
+	UpdateJoinStyleFrom
+	// UpdateJoinStyleFromJoin outputs UPDATE t SET ... FROM t JOIN o ON ...
+	UpdateJoinStyleFromJoin
+)
+
+// DeleteJoinStyle determines how a DELETE statement that joins the target
+// table to other tables is output
+type DeleteJoinStyle int
+
+const (
+	// DeleteJoinStyleFrom outputs DELETE t FROM t JOIN o ON ...
+	DeleteJoinStyleFrom DeleteJoinStyle = iota
+	// DeleteJoinStyleUsing outputs DELETE FROM t USING o WHERE ...
+	DeleteJoinStyleUsing
+	// DeleteJoinStyleExists outputs DELETE FROM t WHERE EXISTS (SELECT 1
+	// FROM o WHERE ...)
+	DeleteJoinStyleExists
+)
+
+var (
+	mu       sync.RWMutex
+	dialects = map[types.Dialect]Dialect{
+		types.DialectMySQL:      MySQL{},
+		types.DialectPostgreSQL: PostgreSQL{},
+		types.DialectSQLite:     SQLite{},
+		types.DialectTSQL:       TSQL{},
+	}
+	drivers = map[string]types.Dialect{
+		"*mysql.MySQLDriver":    types.DialectMySQL,
+		"*mssql.MssqlDriver":    types.DialectTSQL, // github.com/denisenkom/go-mssqldb
+		"*mssql.Driver":         types.DialectTSQL, // github.com/microsoft/go-mssqldb
+		"*pq.Driver":            types.DialectPostgreSQL,
+		"*stdlib.Driver":        types.DialectPostgreSQL,
+		"*sqlite3.SQLiteDriver": types.DialectSQLite, // github.com/mattn/go-sqlite3
+		"*sqlite.Driver":        types.DialectSQLite, // modernc.org/sqlite
+	}
+)
+
+// Register registers the supplied Dialect with the supplied identifier,
+// replacing any Dialect previously registered with that identifier. Custom
+// dialects should use identifiers greater than or equal to
+// types.DialectCustom.
+func Register(id types.Dialect, d Dialect) {
+	mu.Lock()
+	defer mu.Unlock()
+	dialects[id] = d
+}
+
+// Get returns the Dialect registered with the supplied identifier. If no
+// Dialect is registered with the identifier, the ANSI Dialect is returned.
+func Get(id types.Dialect) Dialect {
+	mu.RLock()
+	defer mu.RUnlock()
+	if d, found := dialects[id]; found {
+		return d
+	}
+	return ANSI{}
+}
+
+// RegisterDriver maps the supplied database/sql driver type name, e.g.
+// "*pq.Driver", to the supplied Dialect identifier. The type name is the
+// string representation of the reflected type of the sql.DB's Driver.
+func RegisterDriver(driverType string, id types.Dialect) {
+	mu.Lock()
+	defer mu.Unlock()
+	drivers[driverType] = id
+}
+
+// ForDriver returns the Dialect identifier mapped to the supplied
+// database/sql driver type name, or types.DialectUnknown if the driver type
+// is not mapped.
+func ForDriver(driverType string) types.Dialect {
+	mu.RLock()
+	defer mu.RUnlock()
+	if id, found := drivers[driverType]; found {
+		return id
+	}
+	return types.DialectUnknown
+}
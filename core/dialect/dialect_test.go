@@ -0,0 +1,119 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/dialect"
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cockroach is a custom Dialect that behaves like PostgreSQL except that it
+// supports ORDER BY and LIMIT in UPDATE and DELETE statements
+type cockroach struct {
+	dialect.PostgreSQL
+}
+
+func (cockroach) Name() string {
+	return "CockroachDB"
+}
+
+func (c cockroach) Supports(feature dialect.Feature, version string) bool {
+	if feature == dialect.FeatureDMLOrderByLimit {
+		return true
+	}
+	return c.PostgreSQL.Supports(feature, version)
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		id   types.Dialect
+		name string
+	}{
+		{types.DialectUnknown, "ANSI"},
+		{types.DialectMySQL, "MySQL"},
+		{types.DialectPostgreSQL, "PostgreSQL"},
+		{types.DialectSQLite, "SQLite"},
+		{types.DialectTSQL, "T-SQL"},
+		{types.DialectCustom + 42, "ANSI"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.name, dialect.Get(tt.id).Name())
+		})
+	}
+}
+
+func TestForDriver(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(types.DialectPostgreSQL, dialect.ForDriver("*pq.Driver"))
+	assert.Equal(types.DialectUnknown, dialect.ForDriver("*unknown.Driver"))
+
+	id := types.DialectCustom + 1
+	dialect.RegisterDriver("*crdb.Driver", id)
+	assert.Equal(id, dialect.ForDriver("*crdb.Driver"))
+}
+
+func TestRegisterCustomDialect(t *testing.T) {
+	assert := assert.New(t)
+
+	id := types.DialectCustom + 2
+	dialect.Register(id, cockroach{})
+	assert.Equal("CockroachDB", dialect.Get(id).Name())
+
+	m := &meta.Meta{Name: "test"}
+	users := meta.NewTable(m, "users", "id", "name")
+	stmt := expr.Update(users, map[string]interface{}{"name": "foo"}).
+		OrderBy(users.C("id")).Limit(10)
+
+	b := builder.New(types.WithDialect(id))
+	qs, qargs := b.StringArgs(stmt.UpdateStatementSearched())
+	assert.Equal("UPDATE users SET name = $1 ORDER BY users.id LIMIT $2", qs)
+	assert.Equal([]interface{}{"foo", 10}, qargs)
+
+	b = builder.New(types.WithDialect(types.DialectPostgreSQL))
+	qs, _ = b.StringArgs(stmt.UpdateStatementSearched())
+	assert.Equal("UPDATE users SET name = $1 WHERE ctid IN (SELECT ctid FROM users ORDER BY users.id LIMIT $2)", qs)
+}
+
+func TestMySQLIndexReflection(t *testing.T) {
+	queries := dialect.Get(types.DialectMySQL).Reflection().Indexes
+
+	tests := []struct {
+		version     string
+		expressions bool
+	}{
+		{"5.7.44", false},
+		{"8.0.12", false},
+		{"8.0.13-log", true},
+		{"9.2.0", true},
+		// An unknown version is assumed to be recent
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			var run []string
+			for _, q := range queries {
+				if q.Runs == nil || q.Runs(tt.version) {
+					run = append(run, q.SQL)
+				}
+			}
+			require.Len(t, run, 1)
+			assert.Equal(
+				t, tt.expressions, strings.Contains(run[0], "s.EXPRESSION"),
+			)
+		})
+	}
+}
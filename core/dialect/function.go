@@ -0,0 +1,39 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+// FunctionSyntax determines how a SQL function is output
+type FunctionSyntax int
+
+const (
+	// FunctionSyntaxStandard outputs the ANSI SQL syntax of the function,
+	// e.g. SUBSTRING(x FROM 1 FOR 2) or POSITION(a IN b)
+	FunctionSyntaxStandard FunctionSyntax = iota
+	// FunctionSyntaxArguments outputs the function as an ordinary function
+	// call with its operands, in the order they appear in the ANSI SQL
+	// syntax, as a comma-separated argument list, e.g. SUBSTRING(x, 1, 2) or
+	// CHARINDEX(a, b)
+	FunctionSyntaxArguments
+	// FunctionSyntaxReversedArguments is FunctionSyntaxArguments with the
+	// first two operands swapped, e.g. INSTR(b, a)
+	FunctionSyntaxReversedArguments
+	// FunctionSyntaxUnsupported indicates that the dialect has no equivalent
+	// of the function
+	FunctionSyntaxUnsupported
+)
+
+// functionSyntax returns the FunctionSyntax in the supplied map for the
+// supplied ANSI SQL function name, defaulting to FunctionSyntaxStandard
+func functionSyntax(
+	syntaxes map[string]FunctionSyntax,
+	name string,
+) FunctionSyntax {
+	if syntax, found := syntaxes[name]; found {
+		return syntax
+	}
+	return FunctionSyntaxStandard
+}
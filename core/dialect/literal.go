@@ -0,0 +1,30 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+)
+
+// timestampLayout is the time.Format layout of the date and time in a
+// timestamp literal. Fractional seconds are output only if non-zero.
+const timestampLayout = "2006-01-02 15:04:05.999999"
+
+// quoteString returns the supplied string surrounded by single quotes, with
+// any single quote in the string doubled. If escapeBackslash is true, any
+// backslash in the string is also doubled, for dialects such as MySQL that
+// treat the backslash as an escape character in string literals.
+func quoteString(value string, escapeBackslash bool) string {
+	if escapeBackslash {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	return symbol.Quote +
+		strings.ReplaceAll(value, symbol.Quote, symbol.Quote+symbol.Quote) +
+		symbol.Quote
+}
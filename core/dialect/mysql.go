@@ -0,0 +1,247 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+const (
+	selDBNameMySQL  = "SELECT DATABASE()"
+	selVersionMySQL = "SELECT VERSION()"
+	selTablesMySQL  = `
+SELECT t.TABLE_SCHEMA, t.TABLE_NAME, t.TABLE_TYPE, COALESCE(v.IS_UPDATABLE, 'NO')
+FROM INFORMATION_SCHEMA.TABLES AS t
+LEFT JOIN INFORMATION_SCHEMA.VIEWS AS v
+ ON v.TABLE_SCHEMA = t.TABLE_SCHEMA
+ AND v.TABLE_NAME = t.TABLE_NAME
+WHERE t.TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+AND %s
+ORDER BY t.TABLE_SCHEMA, t.TABLE_NAME
+`
+	selColumnsMySQL = `
+SELECT
+  c.TABLE_SCHEMA
+, c.TABLE_NAME
+, c.COLUMN_NAME
+, c.DATA_TYPE
+, c.CHARACTER_MAXIMUM_LENGTH
+, c.NUMERIC_PRECISION
+, c.NUMERIC_SCALE
+, c.IS_NULLABLE
+, c.COLUMN_DEFAULT
+, c.EXTRA LIKE '%%auto_increment%%'
+, c.COLLATION_NAME
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN INFORMATION_SCHEMA.TABLES AS t
+ ON t.TABLE_SCHEMA = c.TABLE_SCHEMA
+ AND t.TABLE_NAME = c.TABLE_NAME
+WHERE %s
+AND t.TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION
+`
+	selConstraintsMySQL = `
+SELECT tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, tc.CONSTRAINT_TYPE, kcu.COLUMN_NAME
+FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS AS tc
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu
+ ON kcu.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA
+ AND kcu.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+ AND kcu.TABLE_NAME = tc.TABLE_NAME
+WHERE %s
+AND tc.CONSTRAINT_TYPE IN ('PRIMARY KEY', 'UNIQUE')
+ORDER BY tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION
+`
+	selForeignKeysMySQL = `
+SELECT
+  kcu.TABLE_SCHEMA
+, kcu.TABLE_NAME
+, kcu.CONSTRAINT_NAME
+, kcu.COLUMN_NAME
+, kcu.REFERENCED_TABLE_SCHEMA
+, kcu.REFERENCED_TABLE_NAME
+, kcu.REFERENCED_COLUMN_NAME
+FROM INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS AS rc
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu
+ ON kcu.CONSTRAINT_SCHEMA = rc.CONSTRAINT_SCHEMA
+ AND kcu.CONSTRAINT_NAME = rc.CONSTRAINT_NAME
+ AND kcu.TABLE_NAME = rc.TABLE_NAME
+WHERE %s
+ORDER BY kcu.TABLE_SCHEMA, kcu.TABLE_NAME, kcu.CONSTRAINT_NAME, kcu.ORDINAL_POSITION
+`
+	// MySQL has no partial indexes, and no functional key parts before
+	// MySQL 8.0.13
+	selIndexesMySQL = `
+SELECT
+  s.TABLE_SCHEMA
+, s.TABLE_NAME
+, s.INDEX_NAME
+, s.NON_UNIQUE = 0
+, s.INDEX_NAME = 'PRIMARY'
+, FALSE
+, COALESCE(s.COLUMN_NAME, '')
+, ''
+FROM INFORMATION_SCHEMA.STATISTICS AS s
+JOIN INFORMATION_SCHEMA.TABLES AS t
+ ON t.TABLE_SCHEMA = s.TABLE_SCHEMA
+ AND t.TABLE_NAME = s.TABLE_NAME
+WHERE %s
+AND t.TABLE_TYPE = 'BASE TABLE'
+ORDER BY s.TABLE_SCHEMA, s.TABLE_NAME, s.INDEX_NAME, s.SEQ_IN_INDEX
+`
+	// From MySQL 8.0.13, the EXPRESSION column contains the text of
+	// functional key parts
+	selIndexExpressionsMySQL = `
+SELECT
+  s.TABLE_SCHEMA
+, s.TABLE_NAME
+, s.INDEX_NAME
+, s.NON_UNIQUE = 0
+, s.INDEX_NAME = 'PRIMARY'
+, FALSE
+, COALESCE(s.COLUMN_NAME, '')
+, COALESCE(s.EXPRESSION, '')
+FROM INFORMATION_SCHEMA.STATISTICS AS s
+JOIN INFORMATION_SCHEMA.TABLES AS t
+ ON t.TABLE_SCHEMA = s.TABLE_SCHEMA
+ AND t.TABLE_NAME = s.TABLE_NAME
+WHERE %s
+AND t.TABLE_TYPE = 'BASE TABLE'
+ORDER BY s.TABLE_SCHEMA, s.TABLE_NAME, s.INDEX_NAME, s.SEQ_IN_INDEX
+`
+)
+
+// MySQL is the Dialect for MySQL
+type MySQL struct {
+	ANSI
+}
+
+// mysqlFunctionNames maps ANSI SQL function names to their MySQL names
+var mysqlFunctionNames = map[string]string{
+	symbol.Overlay: "INSERT",
+}
+
+// mysqlFunctionSyntaxes maps ANSI SQL function names to the syntax MySQL uses
+// for them
+var mysqlFunctionSyntaxes = map[string]FunctionSyntax{
+	symbol.CharLength:       FunctionSyntaxArguments,
+	symbol.OctetLength:      FunctionSyntaxArguments,
+	symbol.Overlay:          FunctionSyntaxArguments,
+	symbol.CurrentDate:      FunctionSyntaxArguments,
+	symbol.CurrentTime:      FunctionSyntaxArguments,
+	symbol.CurrentTimestamp: FunctionSyntaxArguments,
+	symbol.LocalTime:        FunctionSyntaxArguments,
+	symbol.LocalTimestamp:   FunctionSyntaxArguments,
+	symbol.Similar:          FunctionSyntaxUnsupported,
+	symbol.Translate:        FunctionSyntaxUnsupported,
+	symbol.Normalize:        FunctionSyntaxUnsupported,
+	symbol.Every:            FunctionSyntaxUnsupported,
+	symbol.Any:              FunctionSyntaxUnsupported,
+	symbol.Some:             FunctionSyntaxUnsupported,
+	symbol.Collect:          FunctionSyntaxUnsupported,
+	symbol.Fusion:           FunctionSyntaxUnsupported,
+	symbol.Intersection:     FunctionSyntaxUnsupported,
+}
+
+// Name returns the name of the dialect
+func (MySQL) Name() string {
+	return "MySQL"
+}
+
+// IdentifierQuotes returns the opening and closing characters that delimit
+// an identifier
+func (MySQL) IdentifierQuotes() (string, string) {
+	return symbol.Backtick, symbol.Backtick
+}
+
+// IsReserved returns true if the supplied word is an ANSI SQL:2003 or MySQL
+// reserved word
+func (MySQL) IsReserved(word string) bool {
+	return symbol.IsANSI2003Reserved(word) || symbol.IsMySQLReserved(word)
+}
+
+// ConcatenationOperator returns an empty string, since MySQL treats "||" as
+// a logical OR operator unless the PIPES_AS_CONCAT SQL mode is enabled.
+// Strings are concatenated using the CONCAT() function instead.
+func (MySQL) ConcatenationOperator() string {
+	return ""
+}
+
+// StringLiteral returns the supplied string as a character string literal.
+// MySQL treats the backslash as an escape character in string literals, so
+// backslashes are doubled as well as single quotes.
+func (MySQL) StringLiteral(value string) string {
+	return quoteString(value, true)
+}
+
+// FunctionName returns the MySQL name for the supplied ANSI SQL function name
+func (MySQL) FunctionName(name string) string {
+	if mapped, found := mysqlFunctionNames[name]; found {
+		return mapped
+	}
+	return name
+}
+
+// FunctionSyntax returns the syntax used to output the supplied ANSI SQL
+// function
+func (MySQL) FunctionSyntax(name string) FunctionSyntax {
+	return functionSyntax(mysqlFunctionSyntaxes, name)
+}
+
+// Supports returns true if the supplied Feature is supported. MySQL has no
+// RETURNING clause and no FULL OUTER JOIN.
+func (MySQL) Supports(feature Feature, version string) bool {
+	switch feature {
+	case FeatureReturning, FeatureFullJoin, FeatureOutputClause,
+		FeatureTruncateOptions:
+		return false
+	}
+	return true
+}
+
+// Reflection returns the queries used to reflect the database's catalog
+func (MySQL) Reflection() *Reflection {
+	return &Reflection{
+		DatabaseName: selDBNameMySQL,
+		Version:      selVersionMySQL,
+		SystemSchemas: []string{
+			"information_schema", "mysql", "performance_schema", "sys",
+		},
+		Tables: []ReflectionQuery{
+			{SQL: selTablesMySQL, SchemaColumns: []string{"t.TABLE_SCHEMA"}},
+		},
+		Columns: []ReflectionQuery{
+			{SQL: selColumnsMySQL, SchemaColumns: []string{"c.TABLE_SCHEMA"}},
+		},
+		Constraints: []ReflectionQuery{
+			{SQL: selConstraintsMySQL, SchemaColumns: []string{"tc.TABLE_SCHEMA"}},
+		},
+		ForeignKeys: []ReflectionQuery{
+			{SQL: selForeignKeysMySQL, SchemaColumns: []string{"rc.CONSTRAINT_SCHEMA"}},
+		},
+		Indexes: []ReflectionQuery{
+			{
+				SQL:           selIndexesMySQL,
+				SchemaColumns: []string{"s.TABLE_SCHEMA"},
+				Runs: func(version string) bool {
+					return !mysqlIndexExpressions(version)
+				},
+			},
+			{
+				SQL:           selIndexExpressionsMySQL,
+				SchemaColumns: []string{"s.TABLE_SCHEMA"},
+				Runs:          mysqlIndexExpressions,
+			},
+		},
+	}
+}
+
+// mysqlIndexExpressions returns true if the supplied version of MySQL reports
+// the text of the functional key parts of indexes
+func mysqlIndexExpressions(version string) bool {
+	return types.VersionAtLeastPatch(version, 8, 0, 13)
+}
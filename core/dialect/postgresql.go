@@ -0,0 +1,275 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+)
+
+const (
+	selDBNamePostgreSQL  = "SELECT CURRENT_DATABASE()"
+	selVersionPostgreSQL = "SHOW server_version"
+	selTablesPostgreSQL  = `
+SELECT t.TABLE_SCHEMA, t.TABLE_NAME, t.TABLE_TYPE, COALESCE(v.IS_UPDATABLE, 'NO')
+FROM INFORMATION_SCHEMA.TABLES AS t
+LEFT JOIN INFORMATION_SCHEMA.VIEWS AS v
+ ON v.TABLE_CATALOG = t.TABLE_CATALOG
+ AND v.TABLE_SCHEMA = t.TABLE_SCHEMA
+ AND v.TABLE_NAME = t.TABLE_NAME
+WHERE %s
+AND t.TABLE_CATALOG = $1
+AND t.TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+UNION ALL
+SELECT mv.schemaname, mv.matviewname, 'MATERIALIZED VIEW', 'NO'
+FROM pg_catalog.pg_matviews AS mv
+WHERE %s
+AND current_database() = $1
+ORDER BY 1, 2
+`
+	selColumnsPostgreSQL = `
+SELECT
+  c.TABLE_SCHEMA
+, c.TABLE_NAME
+, c.COLUMN_NAME
+, c.DATA_TYPE
+, c.CHARACTER_MAXIMUM_LENGTH
+, c.NUMERIC_PRECISION
+, c.NUMERIC_SCALE
+, c.IS_NULLABLE
+, c.COLUMN_DEFAULT
+, (c.IS_IDENTITY = 'YES' OR COALESCE(c.COLUMN_DEFAULT, '') LIKE 'nextval(%%')
+, c.COLLATION_NAME
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN INFORMATION_SCHEMA.TABLES AS t
+ ON t.TABLE_SCHEMA = c.TABLE_SCHEMA
+ AND t.TABLE_NAME = c.TABLE_NAME
+WHERE %s
+AND c.TABLE_CATALOG = $1
+AND t.TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION
+`
+	// Materialized views do not appear in INFORMATION_SCHEMA.COLUMNS
+	selMaterializedViewColumnsPostgreSQL = `
+SELECT
+  n.nspname
+, c.relname
+, a.attname
+, format_type(a.atttypid, NULL)
+, NULL::bigint
+, NULL::bigint
+, NULL::bigint
+, CASE WHEN a.attnotnull THEN 'NO' ELSE 'YES' END
+, NULL::text
+, FALSE
+, NULL::text
+FROM pg_catalog.pg_attribute AS a
+JOIN pg_catalog.pg_class AS c
+ ON c.oid = a.attrelid
+JOIN pg_catalog.pg_namespace AS n
+ ON n.oid = c.relnamespace
+WHERE %s
+AND c.relkind = 'm'
+AND a.attnum > 0
+AND NOT a.attisdropped
+AND current_database() = $1
+ORDER BY n.nspname, c.relname, a.attnum
+`
+	selConstraintsPostgreSQL = `
+SELECT tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, tc.CONSTRAINT_TYPE, kcu.COLUMN_NAME
+FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS AS tc
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu
+ ON kcu.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA
+ AND kcu.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+ AND kcu.TABLE_NAME = tc.TABLE_NAME
+WHERE %s
+AND tc.TABLE_CATALOG = $1
+AND tc.CONSTRAINT_TYPE IN ('PRIMARY KEY', 'UNIQUE')
+ORDER BY tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION
+`
+	selForeignKeysPostgreSQL = `
+SELECT
+  kcu.TABLE_SCHEMA
+, kcu.TABLE_NAME
+, kcu.CONSTRAINT_NAME
+, kcu.COLUMN_NAME
+, ukcu.TABLE_SCHEMA
+, ukcu.TABLE_NAME
+, ukcu.COLUMN_NAME
+FROM INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS AS rc
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu
+ ON kcu.CONSTRAINT_SCHEMA = rc.CONSTRAINT_SCHEMA
+ AND kcu.CONSTRAINT_NAME = rc.CONSTRAINT_NAME
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS ukcu
+ ON ukcu.CONSTRAINT_SCHEMA = rc.UNIQUE_CONSTRAINT_SCHEMA
+ AND ukcu.CONSTRAINT_NAME = rc.UNIQUE_CONSTRAINT_NAME
+ AND ukcu.ORDINAL_POSITION = kcu.POSITION_IN_UNIQUE_CONSTRAINT
+WHERE %s
+AND rc.CONSTRAINT_CATALOG = $1
+ORDER BY kcu.TABLE_SCHEMA, kcu.TABLE_NAME, kcu.CONSTRAINT_NAME, kcu.ORDINAL_POSITION
+`
+	selIndexesPostgreSQL = `
+SELECT
+  n.nspname
+, t.relname
+, i.relname
+, ix.indisunique
+, ix.indisprimary
+, ix.indpred IS NOT NULL
+, COALESCE(a.attname, '')
+, CASE WHEN k.attnum = 0 THEN pg_get_indexdef(ix.indexrelid, k.pos::int, true) ELSE '' END
+FROM pg_catalog.pg_index AS ix
+JOIN pg_catalog.pg_class AS t
+ ON t.oid = ix.indrelid
+JOIN pg_catalog.pg_class AS i
+ ON i.oid = ix.indexrelid
+JOIN pg_catalog.pg_namespace AS n
+ ON n.oid = t.relnamespace
+CROSS JOIN LATERAL unnest(ix.indkey::int2[]) WITH ORDINALITY AS k(attnum, pos)
+LEFT JOIN pg_catalog.pg_attribute AS a
+ ON a.attrelid = t.oid
+ AND a.attnum = k.attnum
+WHERE %s
+AND t.relkind IN ('r', 'm')
+AND k.pos <= ix.indnkeyatts
+AND current_database() = $1
+ORDER BY n.nspname, t.relname, i.relname, k.pos
+`
+)
+
+// postgreSQLFunctionNames maps ANSI SQL function names to their PostgreSQL
+// names
+var postgreSQLFunctionNames = map[string]string{
+	symbol.Any:  "BOOL_OR",
+	symbol.Some: "BOOL_OR",
+}
+
+// postgreSQLFunctionSyntaxes maps ANSI SQL function names to the syntax
+// PostgreSQL uses for them
+var postgreSQLFunctionSyntaxes = map[string]FunctionSyntax{
+	symbol.CharLength:   FunctionSyntaxArguments,
+	symbol.OctetLength:  FunctionSyntaxArguments,
+	symbol.Convert:      FunctionSyntaxUnsupported,
+	symbol.Translate:    FunctionSyntaxUnsupported,
+	symbol.Collect:      FunctionSyntaxUnsupported,
+	symbol.Fusion:       FunctionSyntaxUnsupported,
+	symbol.Intersection: FunctionSyntaxUnsupported,
+}
+
+// PostgreSQL is the Dialect for PostgreSQL
+type PostgreSQL struct {
+	ANSI
+}
+
+// Name returns the name of the dialect
+func (PostgreSQL) Name() string {
+	return "PostgreSQL"
+}
+
+// Placeholder returns the query argument marker for the query argument at the
+// supplied zero-based position, e.g. "$1"
+func (PostgreSQL) Placeholder(position int) string {
+	return symbol.Dollar + strconv.Itoa(position+1)
+}
+
+// IsReserved returns true if the supplied word is an ANSI SQL:2003 or
+// PostgreSQL reserved word
+func (PostgreSQL) IsReserved(word string) bool {
+	return symbol.IsANSI2003Reserved(word) ||
+		symbol.IsPostgreSQLReserved(word)
+}
+
+// FoldsToLowerCase returns true, since PostgreSQL folds undelimited
+// identifiers to lower case
+func (PostgreSQL) FoldsToLowerCase() bool {
+	return true
+}
+
+// RowIdentifier returns the name of the system column that identifies the
+// physical location of a row in a table
+func (PostgreSQL) RowIdentifier() string {
+	return symbol.Ctid
+}
+
+// UpdateJoinStyle returns how an UPDATE statement joining the target table to
+// other tables is output
+func (PostgreSQL) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleFrom
+}
+
+// DeleteJoinStyle returns how a DELETE statement joining the target table to
+// other tables is output
+func (PostgreSQL) DeleteJoinStyle() DeleteJoinStyle {
+	return DeleteJoinStyleUsing
+}
+
+// BinaryLiteral returns the supplied bytes as a bytea literal in hex format,
+// e.g. '\xdeadbeef'::bytea
+func (PostgreSQL) BinaryLiteral(value []byte) string {
+	return quoteString(`\x`+hex.EncodeToString(value), false) + "::bytea"
+}
+
+// FunctionName returns the PostgreSQL name for the supplied ANSI SQL function
+// name
+func (PostgreSQL) FunctionName(name string) string {
+	if mapped, found := postgreSQLFunctionNames[name]; found {
+		return mapped
+	}
+	return name
+}
+
+// FunctionSyntax returns the syntax used to output the supplied ANSI SQL
+// function
+func (PostgreSQL) FunctionSyntax(name string) FunctionSyntax {
+	return functionSyntax(postgreSQLFunctionSyntaxes, name)
+}
+
+// Supports returns true if the supplied Feature is supported
+func (PostgreSQL) Supports(feature Feature, version string) bool {
+	switch feature {
+	case FeatureOutputClause, FeatureDMLOrderByLimit:
+		return false
+	}
+	return true
+}
+
+// Reflection returns the queries used to reflect the database's catalog
+func (PostgreSQL) Reflection() *Reflection {
+	return &Reflection{
+		DatabaseName:  selDBNamePostgreSQL,
+		Version:       selVersionPostgreSQL,
+		DefaultSchema: "public",
+		SystemSchemas: []string{"information_schema", "pg_catalog"},
+		// PostgreSQL reserves all schema names beginning with "pg_"
+		SystemSchemaCondition: "left(%s, 3) <> 'pg_'",
+		CatalogArg:            true,
+		Tables: []ReflectionQuery{
+			{
+				SQL:           selTablesPostgreSQL,
+				SchemaColumns: []string{"t.TABLE_SCHEMA", "mv.schemaname"},
+			},
+		},
+		Columns: []ReflectionQuery{
+			{SQL: selColumnsPostgreSQL, SchemaColumns: []string{"c.TABLE_SCHEMA"}},
+			{
+				SQL:           selMaterializedViewColumnsPostgreSQL,
+				SchemaColumns: []string{"n.nspname"},
+			},
+		},
+		Constraints: []ReflectionQuery{
+			{SQL: selConstraintsPostgreSQL, SchemaColumns: []string{"tc.TABLE_SCHEMA"}},
+		},
+		ForeignKeys: []ReflectionQuery{
+			{SQL: selForeignKeysPostgreSQL, SchemaColumns: []string{"rc.CONSTRAINT_SCHEMA"}},
+		},
+		Indexes: []ReflectionQuery{
+			{SQL: selIndexesPostgreSQL, SchemaColumns: []string{"n.nspname"}},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+// Reflection describes the queries that `core/reflect` uses to discover the
+// tables, views, columns, constraints and indexes in a database.
+type Reflection struct {
+	// DatabaseName is the query returning the name of the connection's
+	// database
+	DatabaseName string
+	// Version is the query returning the version string of the RDBMS
+	Version string
+	// DefaultSchema is the schema that unqualified table names refer to. If
+	// empty, the default schema is the connection's database, since MySQL
+	// schemas are databases.
+	DefaultSchema string
+	// SystemSchemas are the RDBMS's own schemas, which are never reflected
+	SystemSchemas []string
+	// SystemSchemaCondition is an optional format string with a single %s
+	// verb, replaced with a schema name column, that excludes further system
+	// schemas, e.g. schemas with a reserved name prefix
+	SystemSchemaCondition string
+	// CatalogArg indicates that the database name is always passed as the
+	// first query argument of the queries below
+	CatalogArg bool
+	// SQLiteCatalog indicates that the tables are reflected from SQLite's
+	// sqlite_master table and table-valued pragma functions instead of using
+	// the queries below
+	SQLiteCatalog bool
+	// Tables return the schema name, table name, table type ("BASE TABLE",
+	// "VIEW" or "MATERIALIZED VIEW") and whether a view is updatable ("YES" or
+	// "NO") of each table
+	Tables []ReflectionQuery
+	// Columns return the schema name, table name, column name, data type,
+	// length, precision, scale, nullability ("YES" or "NO"), default
+	// expression, whether the column is auto-incrementing and collation of
+	// each column
+	Columns []ReflectionQuery
+	// Constraints return the schema name, table name, constraint name,
+	// constraint type ("PRIMARY KEY" or "UNIQUE") and column name of each
+	// column in each primary key and unique constraint
+	Constraints []ReflectionQuery
+	// ForeignKeys return the schema name, table name, constraint name, column
+	// name, referenced schema name, referenced table name and referenced
+	// column name of each column in each foreign key
+	ForeignKeys []ReflectionQuery
+	// Indexes return the schema name, table name, index name, whether the
+	// index is unique, primary and partial, and the column name and
+	// expression text of each key part in each index
+	Indexes []ReflectionQuery
+}
+
+// ReflectionQuery is a query against the database's catalog. Each %s verb in
+// the query is replaced with a condition restricting the corresponding
+// schema name column to the schemas being reflected.
+type ReflectionQuery struct {
+	SQL           string
+	SchemaColumns []string
+	// Runs, if not nil, returns true if the query can be run against the
+	// supplied version of the RDBMS. It lets a dialect choose between
+	// queries for older and newer versions of its catalog.
+	Runs func(version string) bool
+}
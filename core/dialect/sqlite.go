@@ -0,0 +1,150 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"time"
+
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+const (
+	// SQLite has no database name. Instead, the database file opened by the
+	// connection is attached as the "main" schema.
+	selDBNameSQLite  = "SELECT name FROM pragma_database_list WHERE seq = 0"
+	selVersionSQLite = "SELECT sqlite_version()"
+)
+
+// sqliteFunctionNames maps ANSI SQL function names to their SQLite names
+var sqliteFunctionNames = map[string]string{
+	symbol.CharLength: "LENGTH",
+	symbol.Position:   "INSTR",
+	symbol.Substring:  "SUBSTR",
+}
+
+// sqliteFunctionSyntaxes maps ANSI SQL function names to the syntax SQLite
+// uses for them
+var sqliteFunctionSyntaxes = map[string]FunctionSyntax{
+	symbol.CharLength:     FunctionSyntaxArguments,
+	symbol.OctetLength:    FunctionSyntaxArguments,
+	symbol.Position:       FunctionSyntaxReversedArguments,
+	symbol.Substring:      FunctionSyntaxArguments,
+	symbol.Trim:           FunctionSyntaxArguments,
+	symbol.Extract:        FunctionSyntaxUnsupported,
+	symbol.Similar:        FunctionSyntaxUnsupported,
+	symbol.Convert:        FunctionSyntaxUnsupported,
+	symbol.Translate:      FunctionSyntaxUnsupported,
+	symbol.Overlay:        FunctionSyntaxUnsupported,
+	symbol.Normalize:      FunctionSyntaxUnsupported,
+	symbol.LocalTime:      FunctionSyntaxUnsupported,
+	symbol.LocalTimestamp: FunctionSyntaxUnsupported,
+	symbol.Every:          FunctionSyntaxUnsupported,
+	symbol.Any:            FunctionSyntaxUnsupported,
+	symbol.Some:           FunctionSyntaxUnsupported,
+	symbol.StddevPop:      FunctionSyntaxUnsupported,
+	symbol.StddevSamp:     FunctionSyntaxUnsupported,
+	symbol.VarPop:         FunctionSyntaxUnsupported,
+	symbol.VarSamp:        FunctionSyntaxUnsupported,
+	symbol.Collect:        FunctionSyntaxUnsupported,
+	symbol.Fusion:         FunctionSyntaxUnsupported,
+	symbol.Intersection:   FunctionSyntaxUnsupported,
+}
+
+// SQLite is the Dialect for SQLite
+type SQLite struct {
+	ANSI
+}
+
+// Name returns the name of the dialect
+func (SQLite) Name() string {
+	return "SQLite"
+}
+
+// IsReserved returns true if the supplied word is an ANSI SQL:2003 reserved
+// word or an SQLite keyword
+func (SQLite) IsReserved(word string) bool {
+	return symbol.IsANSI2003Reserved(word) || symbol.IsSQLiteReserved(word)
+}
+
+// FunctionName returns the SQLite name for the supplied ANSI SQL function
+// name
+func (SQLite) FunctionName(name string) string {
+	if mapped, found := sqliteFunctionNames[name]; found {
+		return mapped
+	}
+	return name
+}
+
+// FunctionSyntax returns the syntax used to output the supplied ANSI SQL
+// function
+func (SQLite) FunctionSyntax(name string) FunctionSyntax {
+	return functionSyntax(sqliteFunctionSyntaxes, name)
+}
+
+// BooleanLiteral returns the literal representing the supplied boolean value.
+// SQLite stores boolean values as the integers 1 and 0.
+func (SQLite) BooleanLiteral(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+// TimestampLiteral returns the supplied time as a string literal. SQLite has
+// no TIMESTAMP type and stores dates and times as text.
+func (SQLite) TimestampLiteral(value time.Time) string {
+	return quoteString(value.Format(timestampLayout), false)
+}
+
+// RowIdentifier returns the name of the column that identifies a row in a
+// table
+func (SQLite) RowIdentifier() string {
+	return symbol.RowID
+}
+
+// UpdateJoinStyle returns how an UPDATE statement joining the target table to
+// other tables is output. SQLite supports UPDATE ... FROM from version 3.33.
+func (SQLite) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleFrom
+}
+
+// DeleteJoinStyle returns DeleteJoinStyleExists, since SQLite has no
+// multi-table DELETE syntax
+func (SQLite) DeleteJoinStyle() DeleteJoinStyle {
+	return DeleteJoinStyleExists
+}
+
+// Supports returns true if the supplied version of SQLite supports the
+// supplied Feature. SQLite has no TRUNCATE statement, has no fractional
+// seconds precision for its date and time functions, supports UPDATE ...
+// FROM from version 3.33, supports the RETURNING clause from version 3.35 and
+// supports RIGHT and FULL joins from version 3.39.
+func (SQLite) Supports(feature Feature, version string) bool {
+	switch feature {
+	case FeatureOutputClause, FeatureTruncate, FeatureTruncateOptions,
+		FeatureDMLOrderByLimit, FeatureDatetimePrecision:
+		return false
+	case FeatureUpdateFrom:
+		return types.VersionAtLeast(version, 3, 33)
+	case FeatureReturning:
+		return types.VersionAtLeast(version, 3, 35)
+	case FeatureRightJoin, FeatureFullJoin:
+		return types.VersionAtLeast(version, 3, 39)
+	}
+	return true
+}
+
+// Reflection returns the queries used to reflect the database's catalog
+func (SQLite) Reflection() *Reflection {
+	return &Reflection{
+		DatabaseName:  selDBNameSQLite,
+		Version:       selVersionSQLite,
+		DefaultSchema: "main",
+		SQLiteCatalog: true,
+	}
+}
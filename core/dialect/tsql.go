@@ -0,0 +1,313 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package dialect
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+const (
+	selDBNameTSQL = "SELECT DB_NAME()"
+	// SERVERPROPERTY returns a sql_variant, which not all drivers can scan
+	// into a string
+	selVersionTSQL = "SELECT CAST(SERVERPROPERTY('ProductVersion') AS NVARCHAR(128))"
+	// SQL Server always reports its views as not updatable in
+	// INFORMATION_SCHEMA.VIEWS, so reflected views are read-only
+	selTablesTSQL = `
+SELECT t.TABLE_SCHEMA, t.TABLE_NAME, t.TABLE_TYPE, COALESCE(v.IS_UPDATABLE, 'NO')
+FROM INFORMATION_SCHEMA.TABLES AS t
+LEFT JOIN INFORMATION_SCHEMA.VIEWS AS v
+ ON v.TABLE_CATALOG = t.TABLE_CATALOG
+ AND v.TABLE_SCHEMA = t.TABLE_SCHEMA
+ AND v.TABLE_NAME = t.TABLE_NAME
+WHERE %s
+AND t.TABLE_CATALOG = @p1
+AND t.TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+ORDER BY t.TABLE_SCHEMA, t.TABLE_NAME
+`
+	selColumnsTSQL = `
+SELECT
+  c.TABLE_SCHEMA
+, c.TABLE_NAME
+, c.COLUMN_NAME
+, c.DATA_TYPE
+, c.CHARACTER_MAXIMUM_LENGTH
+, c.NUMERIC_PRECISION
+, c.NUMERIC_SCALE
+, c.IS_NULLABLE
+, c.COLUMN_DEFAULT
+, COALESCE(COLUMNPROPERTY(OBJECT_ID(QUOTENAME(c.TABLE_SCHEMA) + '.' + QUOTENAME(c.TABLE_NAME)), c.COLUMN_NAME, 'IsIdentity'), 0)
+, c.COLLATION_NAME
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN INFORMATION_SCHEMA.TABLES AS t
+ ON t.TABLE_CATALOG = c.TABLE_CATALOG
+ AND t.TABLE_SCHEMA = c.TABLE_SCHEMA
+ AND t.TABLE_NAME = c.TABLE_NAME
+WHERE %s
+AND c.TABLE_CATALOG = @p1
+AND t.TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION
+`
+	selConstraintsTSQL = `
+SELECT tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, tc.CONSTRAINT_TYPE, kcu.COLUMN_NAME
+FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS AS tc
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu
+ ON kcu.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA
+ AND kcu.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+ AND kcu.TABLE_NAME = tc.TABLE_NAME
+WHERE %s
+AND tc.TABLE_CATALOG = @p1
+AND tc.CONSTRAINT_TYPE IN ('PRIMARY KEY', 'UNIQUE')
+ORDER BY tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION
+`
+	// SQL Server's INFORMATION_SCHEMA.KEY_COLUMN_USAGE has no
+	// POSITION_IN_UNIQUE_CONSTRAINT column, so the referencing and referenced
+	// columns are paired using the catalog views instead
+	selForeignKeysTSQL = `
+SELECT
+  s.name
+, t.name
+, fk.name
+, c.name
+, rs.name
+, rt.name
+, rc.name
+FROM sys.foreign_keys AS fk
+JOIN sys.foreign_key_columns AS fkc
+ ON fkc.constraint_object_id = fk.object_id
+JOIN sys.tables AS t
+ ON t.object_id = fk.parent_object_id
+JOIN sys.schemas AS s
+ ON s.schema_id = t.schema_id
+JOIN sys.columns AS c
+ ON c.object_id = fkc.parent_object_id
+ AND c.column_id = fkc.parent_column_id
+JOIN sys.tables AS rt
+ ON rt.object_id = fk.referenced_object_id
+JOIN sys.schemas AS rs
+ ON rs.schema_id = rt.schema_id
+JOIN sys.columns AS rc
+ ON rc.object_id = fkc.referenced_object_id
+ AND rc.column_id = fkc.referenced_column_id
+WHERE %s
+AND DB_NAME() = @p1
+ORDER BY s.name, t.name, fk.name, fkc.constraint_column_id
+`
+	selIndexesTSQL = `
+SELECT
+  s.name
+, t.name
+, i.name
+, i.is_unique
+, i.is_primary_key
+, i.has_filter
+, COALESCE(c.name, '')
+, ''
+FROM sys.indexes AS i
+JOIN sys.tables AS t
+ ON t.object_id = i.object_id
+JOIN sys.schemas AS s
+ ON s.schema_id = t.schema_id
+JOIN sys.index_columns AS ic
+ ON ic.object_id = i.object_id
+ AND ic.index_id = i.index_id
+ AND ic.is_included_column = 0
+LEFT JOIN sys.columns AS c
+ ON c.object_id = ic.object_id
+ AND c.column_id = ic.column_id
+WHERE %s
+AND i.type > 0
+AND DB_NAME() = @p1
+ORDER BY s.name, t.name, i.name, ic.key_ordinal
+`
+)
+
+// tsqlFunctionNames maps ANSI SQL function names to their T-SQL names
+var tsqlFunctionNames = map[string]string{
+	symbol.Ceil:             "CEILING",
+	symbol.CharLength:       "LEN",
+	symbol.Ln:               "LOG",
+	symbol.OctetLength:      "DATALENGTH",
+	symbol.Position:         "CHARINDEX",
+	symbol.Extract:          "DATEPART",
+	symbol.Overlay:          "STUFF",
+	symbol.CurrentTimestamp: "GETDATE",
+	symbol.LocalTimestamp:   "GETDATE",
+	symbol.StddevPop:        "STDEVP",
+	symbol.StddevSamp:       "STDEV",
+	symbol.VarPop:           "VARP",
+	symbol.VarSamp:          "VAR",
+}
+
+// tsqlFunctionSyntaxes maps ANSI SQL function names to the syntax T-SQL uses
+// for them
+var tsqlFunctionSyntaxes = map[string]FunctionSyntax{
+	symbol.CharLength:       FunctionSyntaxArguments,
+	symbol.OctetLength:      FunctionSyntaxArguments,
+	symbol.Position:         FunctionSyntaxArguments,
+	symbol.Substring:        FunctionSyntaxArguments,
+	symbol.Extract:          FunctionSyntaxArguments,
+	symbol.Overlay:          FunctionSyntaxArguments,
+	symbol.CurrentTimestamp: FunctionSyntaxArguments,
+	symbol.LocalTimestamp:   FunctionSyntaxArguments,
+	symbol.CurrentDate:      FunctionSyntaxUnsupported,
+	symbol.CurrentTime:      FunctionSyntaxUnsupported,
+	symbol.LocalTime:        FunctionSyntaxUnsupported,
+	symbol.Similar:          FunctionSyntaxUnsupported,
+	symbol.Convert:          FunctionSyntaxUnsupported,
+	symbol.Translate:        FunctionSyntaxUnsupported,
+	symbol.Normalize:        FunctionSyntaxUnsupported,
+	symbol.Every:            FunctionSyntaxUnsupported,
+	symbol.Any:              FunctionSyntaxUnsupported,
+	symbol.Some:             FunctionSyntaxUnsupported,
+	symbol.Collect:          FunctionSyntaxUnsupported,
+	symbol.Fusion:           FunctionSyntaxUnsupported,
+	symbol.Intersection:     FunctionSyntaxUnsupported,
+}
+
+// TSQL is the Dialect for Microsoft SQL Server
+type TSQL struct {
+	ANSI
+}
+
+// Name returns the name of the dialect
+func (TSQL) Name() string {
+	return "T-SQL"
+}
+
+// Placeholder returns the query argument marker for the query argument at the
+// supplied zero-based position, e.g. "@p1"
+func (TSQL) Placeholder(position int) string {
+	return symbol.AtSign + "p" + strconv.Itoa(position+1)
+}
+
+// IdentifierQuotes returns the opening and closing characters that delimit
+// an identifier
+func (TSQL) IdentifierQuotes() (string, string) {
+	return symbol.LeftBracket, symbol.RightBracket
+}
+
+// IsReserved returns true if the supplied word is an ANSI SQL:2003 or T-SQL
+// reserved word
+func (TSQL) IsReserved(word string) bool {
+	return symbol.IsANSI2003Reserved(word) || symbol.IsTSQLReserved(word)
+}
+
+// LimitStyle returns LimitStyleTopOffsetFetch, since T-SQL has no LIMIT
+// clause
+func (TSQL) LimitStyle() LimitStyle {
+	return LimitStyleTopOffsetFetch
+}
+
+// FunctionName returns the T-SQL name for the supplied ANSI SQL function name
+func (TSQL) FunctionName(name string) string {
+	if mapped, found := tsqlFunctionNames[name]; found {
+		return mapped
+	}
+	return name
+}
+
+// FunctionSyntax returns the syntax used to output the supplied ANSI SQL
+// function
+func (TSQL) FunctionSyntax(name string) FunctionSyntax {
+	return functionSyntax(tsqlFunctionSyntaxes, name)
+}
+
+// BooleanLiteral returns the literal representing the supplied boolean value.
+// T-SQL has no boolean literals, so the BIT values 1 and 0 are used.
+func (TSQL) BooleanLiteral(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+// StringLiteral returns the supplied string as a character string literal,
+// which is prefixed with N if the string contains characters outside of the
+// ASCII range so that SQL Server treats it as a Unicode string
+func (TSQL) StringLiteral(value string) string {
+	for _, r := range value {
+		if r > unicode.MaxASCII {
+			return "N" + quoteString(value, false)
+		}
+	}
+	return quoteString(value, false)
+}
+
+// BinaryLiteral returns the supplied bytes as a binary constant, e.g.
+// 0xDEADBEEF
+func (TSQL) BinaryLiteral(value []byte) string {
+	return "0x" + strings.ToUpper(hex.EncodeToString(value))
+}
+
+// TimestampLiteral returns the supplied time as an ISO 8601 string literal,
+// which SQL Server converts to any date and time type regardless of the
+// session's language and date format settings
+func (TSQL) TimestampLiteral(value time.Time) string {
+	return quoteString(value.Format("2006-01-02T15:04:05.9999999"), false)
+}
+
+// ConcatenationOperator returns the operator that concatenates strings
+func (TSQL) ConcatenationOperator() string {
+	return symbol.PlusSign
+}
+
+// UpdateJoinStyle returns how an UPDATE statement joining the target table to
+// other tables is output
+func (TSQL) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleFromJoin
+}
+
+// Supports returns true if the supplied version of SQL Server supports the
+// supplied Feature. The LEADING and TRAILING specifications of TRIM are
+// supported from SQL Server 2022 (16.x).
+func (TSQL) Supports(feature Feature, version string) bool {
+	switch feature {
+	case FeatureReturning, FeatureTruncateOptions, FeatureDMLOrderByLimit,
+		FeatureDatetimePrecision:
+		return false
+	case FeatureTrimSpecification:
+		return types.VersionAtLeast(version, 16, 0)
+	}
+	return true
+}
+
+// Reflection returns the queries used to reflect the database's catalog
+func (TSQL) Reflection() *Reflection {
+	return &Reflection{
+		DatabaseName:  selDBNameTSQL,
+		Version:       selVersionTSQL,
+		DefaultSchema: "dbo",
+		SystemSchemas: []string{"INFORMATION_SCHEMA", "sys", "guest"},
+		// SQL Server creates a schema for each fixed database role, all of
+		// which begin with "db_"
+		SystemSchemaCondition: "%s NOT LIKE 'db[_]%%'",
+		CatalogArg:            true,
+		Tables: []ReflectionQuery{
+			{SQL: selTablesTSQL, SchemaColumns: []string{"t.TABLE_SCHEMA"}},
+		},
+		Columns: []ReflectionQuery{
+			{SQL: selColumnsTSQL, SchemaColumns: []string{"c.TABLE_SCHEMA"}},
+		},
+		Constraints: []ReflectionQuery{
+			{SQL: selConstraintsTSQL, SchemaColumns: []string{"tc.TABLE_SCHEMA"}},
+		},
+		ForeignKeys: []ReflectionQuery{
+			{SQL: selForeignKeysTSQL, SchemaColumns: []string{"s.name"}},
+		},
+		Indexes: []ReflectionQuery{
+			{SQL: selIndexesTSQL, SchemaColumns: []string{"s.name"}},
+		},
+	}
+}
@@ -0,0 +1,347 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// DeleteStatement wraps a grammar.DeleteStatementSearched, adding chainable
+// methods to modify the DELETE statement.
+type DeleteStatement struct {
+	ds *grammar.DeleteStatementSearched
+	t  *meta.Table
+}
+
+// DeleteStatementSearched returns the object as a
+// `*grammar.DeleteStatementSearched`
+func (s *DeleteStatement) DeleteStatementSearched() *grammar.DeleteStatementSearched {
+	return s.ds
+}
+
+// Delete returns a DeleteStatement that produces a DELETE SQL statement for
+// the supplied Table. Call the Where() method on the returned DeleteStatement
+// to restrict the rows that are deleted.
+//
+// Delete panics if the supplied Table is nil. This is intentional, as we want
+// compile-time failures for invalid SQL construction and we want the result
+// of Delete() to be chainable with other DeleteStatement methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `DeleteE` function which returns a checkable `error` object.
+func Delete(
+	t *meta.Table,
+) *DeleteStatement {
+	s, err := DeleteE(t)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// DeleteE returns a DeleteStatement that produces a DELETE SQL statement for
+// the supplied Table. If the supplied Table is nil or is a read-only view,
+// DeleteE returns an error.
+func DeleteE(
+	t *meta.Table,
+) (*DeleteStatement, error) {
+	if t == nil {
+		return nil, types.TableRequired
+	}
+	if err := t.CheckUpdatable("DELETE FROM"); err != nil {
+		return nil, err
+	}
+	return &DeleteStatement{ds: t.DeleteAll(), t: t}, nil
+}
+
+// Delete returns a DeleteStatement that produces a multi-table DELETE SQL
+// statement. The supplied target Table must be one of
+// the tables referenced in the Selection's FROM clause. Any tables joined to
+// the target via the Selection's Join() method are used to determine the rows
+// in the target table to delete, and the Selection's WHERE clause is used as
+// the DELETE statement's search condition.
+//
+// For example, the following:
+//
+// sel := Select(users).Join(articles, Equal(users.C("id"), articles.C("author")))
+// sel.Where(Equal(articles.C("state"), 1))
+// stmt := sel.Delete(users)
+//
+// produces this SQL for MySQL:
+//
+// DELETE users FROM users JOIN articles ON users.id = articles.author WHERE articles.state = ?
+//
+// and this SQL for PostgreSQL:
+//
+// DELETE FROM users USING articles WHERE users.id = articles.author AND articles.state = $1
+//
+// Delete panics if the Selection cannot be converted into a multi-table
+// DELETE statement. This is intentional, as we want compile-time failures for
+// invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `DeleteE` function which returns a checkable `error` object.
+func (s *Selection) Delete(
+	target *meta.Table,
+) *DeleteStatement {
+	ds, err := s.DeleteE(target)
+	if err != nil {
+		panic(err)
+	}
+	return ds
+}
+
+// DeleteE returns a DeleteStatement that produces a multi-table DELETE SQL
+// statement. The supplied target Table must be one of
+// the tables referenced in the Selection's FROM clause. If the Selection
+// cannot be converted into a multi-table DELETE statement, DeleteE returns an
+// error.
+func (s *Selection) DeleteE(
+	target *meta.Table,
+) (*DeleteStatement, error) {
+	if target == nil {
+		return nil, types.TableRequired
+	}
+	if err := target.CheckUpdatable("DELETE FROM"); err != nil {
+		return nil, err
+	}
+	trefs, err := s.targetTableReferences(target, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	ds := target.DeleteAll()
+	ds.Using = trefs
+	ds.Where = s.qs.TableExpression.Where
+	return &DeleteStatement{ds: ds, t: target}, nil
+}
+
+// Where adapts the DeleteStatement with a filtering expression, returning the
+// DeleteStatement pointer to support method chaining. If the DeleteStatement
+// already has a filtering expression, the supplied expression is ANDed with
+// it.
+//
+// Where panics if the supplied parameter cannot be converted to a
+// BooleanValueExpression. This is intentional, as we want compile-time
+// failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `WhereE` function which returns a checkable `error` object.
+func (s *DeleteStatement) Where(
+	exprAny interface{},
+) *DeleteStatement {
+	res, err := s.WhereE(exprAny)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// WhereE adapts the DeleteStatement with a filtering expression, returning
+// the DeleteStatement pointer to support method chaining. If the supplied
+// parameter cannot be converted to a BooleanValueExpression, WhereE returns
+// an error.
+func (s *DeleteStatement) WhereE(
+	exprAny interface{},
+) (*DeleteStatement, error) {
+	if s == nil || s.ds == nil {
+		return nil, fmt.Errorf(
+			"cannot call Where() on a nil DeleteStatement",
+		)
+	}
+	wc, err := whereClauseFromAny(s.ds.Where, exprAny)
+	if err != nil {
+		return nil, err
+	}
+	s.ds.Where = wc
+	return s, nil
+}
+
+// And ANDs the supplied filtering expression with the DeleteStatement's
+// existing filtering expression, returning the DeleteStatement pointer to
+// support method chaining.
+//
+// And panics if the DeleteStatement has no filtering expression yet or the
+// supplied parameter cannot be converted to a BooleanValueExpression. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `AndE` function which returns a checkable `error` object.
+func (s *DeleteStatement) And(
+	exprAny interface{},
+) *DeleteStatement {
+	res, err := s.AndE(exprAny)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// AndE ANDs the supplied filtering expression with the DeleteStatement's
+// existing filtering expression, returning the DeleteStatement pointer to
+// support method chaining. If the DeleteStatement has no filtering expression
+// yet or the supplied parameter cannot be converted to a
+// BooleanValueExpression, AndE returns an error.
+func (s *DeleteStatement) AndE(
+	exprAny interface{},
+) (*DeleteStatement, error) {
+	if s == nil || s.ds == nil {
+		return nil, fmt.Errorf(
+			"cannot call And() on a nil DeleteStatement",
+		)
+	}
+	if s.ds.Where == nil {
+		return nil, fmt.Errorf("cannot call And() before calling Where()")
+	}
+	return s.WhereE(exprAny)
+}
+
+// Returning adds a RETURNING clause (an OUTPUT clause for T-SQL) to the
+// DeleteStatement, returning the DeleteStatement pointer to support method
+// chaining. The arguments must be either string column names or columns in
+// the target Table. Building the statement fails with an error wrapping
+// types.FeatureNotSupported for dialects, such as MySQL, that have neither a
+// RETURNING nor an OUTPUT clause. When the statement joins the target Table
+// to other tables, the returned columns are qualified with the target Table's
+// name or alias.
+//
+// Returning panics if any of the supplied columns are not in the target
+// Table. This is intentional, as we want compile-time failures for invalid
+// SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `ReturningE` function which returns a checkable `error`
+// object.
+func (s *DeleteStatement) Returning(
+	cols ...interface{},
+) *DeleteStatement {
+	res, err := s.ReturningE(cols...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// ReturningE adds a RETURNING clause (an OUTPUT clause for T-SQL) to the
+// DeleteStatement, returning the DeleteStatement pointer to support method
+// chaining. If any of the supplied columns are not in the target Table,
+// ReturningE returns an error.
+func (s *DeleteStatement) ReturningE(
+	cols ...interface{},
+) (*DeleteStatement, error) {
+	if s == nil || s.ds == nil {
+		return nil, fmt.Errorf(
+			"cannot call Returning() on a nil DeleteStatement",
+		)
+	}
+	rc, err := returningClauseFromAny(s.t, cols...)
+	if err != nil {
+		return nil, err
+	}
+	s.ds.Returning = rc
+	return s, nil
+}
+
+// OrderBy adds an ORDER BY clause to a single-table DeleteStatement,
+// returning the DeleteStatement pointer to support method chaining. The ORDER
+// BY clause determines which rows are deleted when combined with Limit().
+//
+// OrderBy panics if the supplied parameters cannot be converted to
+// SortSpecifications or the DeleteStatement is a multi-table DELETE. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `OrderByE` function which returns a checkable `error`
+// object.
+func (s *DeleteStatement) OrderBy(
+	specAnys ...interface{},
+) *DeleteStatement {
+	res, err := s.OrderByE(specAnys...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// OrderByE adds an ORDER BY clause to a single-table DeleteStatement,
+// returning the DeleteStatement pointer to support method chaining. If the
+// supplied parameters cannot be converted to SortSpecifications or the
+// DeleteStatement is a multi-table DELETE, OrderByE returns an error.
+func (s *DeleteStatement) OrderByE(
+	specAnys ...interface{},
+) (*DeleteStatement, error) {
+	if s == nil || s.ds == nil {
+		return nil, fmt.Errorf(
+			"cannot call OrderBy() on a nil DeleteStatement",
+		)
+	}
+	if len(s.ds.Using) > 0 {
+		return nil, fmt.Errorf(
+			"cannot call OrderBy() on a multi-table DELETE",
+		)
+	}
+	specs, err := sortSpecificationsFromAny(specAnys...)
+	if err != nil {
+		return nil, err
+	}
+	if s.ds.OrderBy == nil {
+		s.ds.OrderBy = &grammar.OrderByClause{
+			SortSpecifications: []grammar.SortSpecification{},
+		}
+	}
+	s.ds.OrderBy.SortSpecifications = append(
+		s.ds.OrderBy.SortSpecifications, specs...,
+	)
+	return s, nil
+}
+
+// Limit limits the number of rows deleted by a single-table DeleteStatement,
+// returning the DeleteStatement pointer to support method chaining. For
+// MySQL, this is output as a LIMIT clause. For PostgreSQL and SQLite, the
+// rows to delete are selected by row identifier in an ordered, limited
+// subquery. For T-SQL, the rows to delete are selected with TOP in a common
+// table expression.
+//
+// Limit panics if the DeleteStatement is a multi-table DELETE. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `LimitE` function which returns a checkable `error` object.
+func (s *DeleteStatement) Limit(
+	count int,
+) *DeleteStatement {
+	res, err := s.LimitE(count)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// LimitE limits the number of rows deleted by a single-table DeleteStatement,
+// returning the DeleteStatement pointer to support method chaining. If the
+// DeleteStatement is a multi-table DELETE, LimitE returns an error.
+func (s *DeleteStatement) LimitE(
+	count int,
+) (*DeleteStatement, error) {
+	if s == nil || s.ds == nil {
+		return nil, fmt.Errorf(
+			"cannot call Limit() on a nil DeleteStatement",
+		)
+	}
+	if len(s.ds.Using) > 0 {
+		return nil, fmt.Errorf(
+			"cannot call Limit() on a multi-table DELETE",
+		)
+	}
+	s.ds.Limit = &grammar.LimitClause{
+		Count: count,
+	}
+	return s, nil
+}
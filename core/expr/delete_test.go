@@ -0,0 +1,278 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectionDelete(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+	colArticleAuthor := articles.C("author")
+	colArticleState := articles.C("state")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		qs      string
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			qs:      "DELETE users FROM users JOIN articles ON users.id = articles.author WHERE articles.state = ?",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "DELETE FROM users USING articles WHERE users.id = articles.author AND articles.state = $1",
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			qs:      "DELETE FROM users WHERE EXISTS (SELECT 1 FROM articles WHERE users.id = articles.author AND articles.state = ?)",
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "DELETE users FROM users JOIN articles ON users.id = articles.author WHERE articles.state = @p1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			sel := expr.Select(colUserId).Join(
+				articles, expr.Equal(colUserId, colArticleAuthor),
+			).Where(expr.Equal(colArticleState, 1))
+			q := sel.Delete(users).DeleteStatementSearched()
+
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, qargs := b.StringArgs(q)
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{1}, qargs)
+		})
+	}
+}
+
+func TestSelectionDeleteAliased(t *testing.T) {
+	assert := assert.New(t)
+
+	m := testutil.M()
+	u := m.T("users").As("u")
+	articles := m.T("articles")
+
+	sel := expr.Select(u.C("id")).Join(
+		articles, expr.Equal(u.C("id"), articles.C("author")),
+	)
+	q := sel.Delete(u).DeleteStatementSearched()
+
+	b := builder.New(types.WithDialect(types.DialectMySQL))
+	qs, _ := b.StringArgs(q)
+	assert.Equal("DELETE u FROM users AS u JOIN articles ON u.id = articles.author", qs)
+
+	b = builder.New(types.WithDialect(types.DialectPostgreSQL))
+	qs, _ = b.StringArgs(q)
+	assert.Equal("DELETE FROM users AS u USING articles WHERE u.id = articles.author", qs)
+}
+
+func TestSelectionDeleteReturning(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		qs      string
+	}{
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "DELETE FROM users USING articles WHERE users.id = articles.author RETURNING users.id",
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			qs:      "DELETE FROM users WHERE EXISTS (SELECT 1 FROM articles WHERE users.id = articles.author) RETURNING users.id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Both tables have an id column, so the RETURNING column is
+			// qualified with the target table
+			sel := expr.Select(users.C("id")).Join(
+				articles, expr.Equal(users.C("id"), articles.C("author")),
+			)
+			q := sel.Delete(users).Returning("id")
+
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, _, err := b.Build(q)
+			require.Nil(t, err)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
+func TestDeleteOrderByLimit(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		qs      string
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			qs:      "DELETE FROM users WHERE users.name = ? ORDER BY users.id LIMIT ?",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "DELETE FROM users WHERE ctid IN (SELECT ctid FROM users WHERE users.name = $1 ORDER BY users.id LIMIT $2)",
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			qs:      "DELETE FROM users WHERE rowid IN (SELECT rowid FROM users WHERE users.name = ? ORDER BY users.id LIMIT ?)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			q := expr.Delete(users).
+				Where(expr.Equal(colUserName, "foo")).
+				OrderBy(colUserId).
+				Limit(1000).
+				DeleteStatementSearched()
+
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, qargs := b.StringArgs(q)
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{"foo", 1000}, qargs)
+		})
+	}
+
+	t.Run("TSQL", func(t *testing.T) {
+		assert := assert.New(t)
+
+		q := expr.Delete(users).
+			Where(expr.Equal(colUserName, "foo")).
+			OrderBy(colUserId).
+			Limit(1000).
+			DeleteStatementSearched()
+
+		b := builder.New(types.WithDialect(types.DialectTSQL))
+		qs, qargs := b.StringArgs(q)
+		assert.Equal("WITH sqlb_limited AS (SELECT TOP (@p1) * FROM users WHERE users.name = @p2 ORDER BY users.id) DELETE FROM sqlb_limited", qs)
+		assert.Equal([]interface{}{1000, "foo"}, qargs)
+	})
+
+	t.Run("multi-table", func(t *testing.T) {
+		articles := m.T("articles")
+		q := expr.Select(colUserId).Join(
+			articles, expr.Equal(colUserId, articles.C("author")),
+		).Delete(users)
+		_, err := q.LimitE(10)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestDeleteStatement(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		version string
+		qs      string
+		err     error
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			err:     types.FeatureNotSupported,
+		},
+		{
+			name:    "SQLite before 3.35",
+			dialect: types.DialectSQLite,
+			version: "3.34.1",
+			err:     types.FeatureNotSupported,
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			version: "3.35.0",
+			qs:      "DELETE FROM users WHERE users.name = ? AND users.id > ? RETURNING id",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "DELETE FROM users WHERE users.name = $1 AND users.id > $2 RETURNING id",
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "DELETE FROM users OUTPUT DELETED.id WHERE users.name = @p1 AND users.id > @p2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			q := expr.Delete(users).
+				Where(expr.Equal(colUserName, "foo")).
+				And(expr.GreaterThan(colUserId, 10)).
+				Returning(colUserId)
+
+			b := builder.New(
+				types.WithDialect(tt.dialect),
+				types.WithDialectVersion(tt.version),
+			)
+			qs, qargs, err := b.Build(q)
+			if tt.err != nil {
+				assert.ErrorIs(err, tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{"foo", 10}, qargs)
+		})
+	}
+}
+
+func TestDeleteStatementErrors(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+
+	_, err := expr.DeleteE(nil)
+	assert.Equal(t, types.TableRequired, err)
+
+	q := expr.Delete(users)
+	_, err = q.AndE(expr.Equal(users.C("name"), "foo"))
+	assert.NotNil(t, err)
+
+	_, err = q.ReturningE()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,117 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/inspect"
+)
+
+// whereClauseFromAny returns a new WhereClause that ANDs the supplied existing
+// WhereClause, if any, with the supplied filtering expression. The existing
+// WhereClause is not modified. If the filtering expression cannot be
+// converted to a BooleanValueExpression, an error is returned.
+func whereClauseFromAny(
+	existing *grammar.WhereClause,
+	exprAny interface{},
+) (*grammar.WhereClause, error) {
+	bve := inspect.BooleanValueExpressionFromAny(exprAny)
+	if bve == nil {
+		return nil, fmt.Errorf(
+			"could not convert %s(%T) to expected BooleanValueExpression",
+			exprAny, exprAny,
+		)
+	}
+	if existing != nil {
+		return &grammar.WhereClause{
+			Search: *andBooleanValueExpressions(&existing.Search, bve),
+		}, nil
+	}
+	return &grammar.WhereClause{
+		Search: *bve,
+	}, nil
+}
+
+// andBooleanValueExpressions returns a BooleanValueExpression that ANDs the
+// supplied left and right BooleanValueExpressions together. Either side that
+// contains an OR is parenthesized to preserve operator precedence.
+func andBooleanValueExpressions(
+	left *grammar.BooleanValueExpression,
+	right *grammar.BooleanValueExpression,
+) *grammar.BooleanValueExpression {
+	lterm := left.Unary
+	if left.OrLeft != nil || lterm == nil {
+		lterm = &grammar.BooleanTerm{
+			Unary: parenthesizedBooleanFactor(left),
+		}
+	}
+	rfactor := parenthesizedBooleanFactor(right)
+	if right.OrLeft == nil && right.Unary != nil && right.Unary.Unary != nil {
+		rfactor = right.Unary.Unary
+	}
+	return &grammar.BooleanValueExpression{
+		Unary: &grammar.BooleanTerm{
+			AndLeft:  lterm,
+			AndRight: rfactor,
+		},
+	}
+}
+
+// parenthesizedBooleanFactor returns a BooleanFactor containing the supplied
+// BooleanValueExpression in parentheses.
+func parenthesizedBooleanFactor(
+	bve *grammar.BooleanValueExpression,
+) *grammar.BooleanFactor {
+	return &grammar.BooleanFactor{
+		Test: grammar.BooleanTest{
+			Primary: grammar.BooleanPrimary{
+				Predicand: &grammar.BooleanPredicand{
+					Parenthesized: bve,
+				},
+			},
+		},
+	}
+}
+
+// returningClauseFromAny returns a ReturningClause containing the names of the
+// supplied columns, which must be either string column names or Projections
+// that refer to columns in the supplied target Table. If any of the supplied
+// columns cannot be found in the target Table, an error is returned.
+func returningClauseFromAny(
+	t *meta.Table,
+	colAnys ...interface{},
+) (*grammar.ReturningClause, error) {
+	if len(colAnys) == 0 {
+		return nil, fmt.Errorf("Returning() requires at least one column")
+	}
+	cols := make([]string, 0, len(colAnys))
+	for _, colAny := range colAnys {
+		var c types.Projection
+		switch v := colAny.(type) {
+		case string:
+			c = t.C(v)
+		case types.Projection:
+			if v.References() == t {
+				c = v
+			}
+		}
+		if c == nil {
+			return nil, fmt.Errorf(
+				"%w: %v(%T) is not a column in table '%s'",
+				types.UnknownColumn, colAny, colAny, t.Name(),
+			)
+		}
+		cols = append(cols, c.Name())
+	}
+	return &grammar.ReturningClause{
+		Columns: cols,
+	}, nil
+}
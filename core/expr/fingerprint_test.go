@@ -0,0 +1,157 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+	colArticleId := articles.C("id")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		target  interface{}
+		qs      string
+	}{
+		{
+			name:    "values and IN list",
+			dialect: types.DialectPostgreSQL,
+			target: expr.Select(colUserName).
+				Where(expr.And(
+					expr.In(colUserId, 1, 2, 3),
+					expr.Equal(colUserName, "foo"),
+				)).
+				LimitWithOffset(10, 20),
+			qs: "SELECT users.name FROM users WHERE users.id IN(?) AND users.name = ? LIMIT ? OFFSET ?",
+		},
+		{
+			name:    "IN list of column references is not collapsed",
+			dialect: types.DialectMySQL,
+			target: expr.Select(colUserName).
+				Where(expr.In(colUserId, colUserId, colUserName)),
+			qs: "SELECT users.name FROM users WHERE users.id IN(users.id, users.name)",
+		},
+		{
+			name:    "FROM relations ordered by name",
+			dialect: types.DialectMySQL,
+			target:  expr.Select(colUserId, colArticleId),
+			qs:      "SELECT users.id, articles.id FROM articles, users",
+		},
+		{
+			name:    "INSERT columns in the name order of meta.Table",
+			dialect: types.DialectTSQL,
+			target: expr.Insert(users, map[string]interface{}{
+				"name": "foo", "id": 1, "created_on": "now",
+			}),
+			qs: "INSERT INTO users (created_on, id, name) VALUES (?, ?, ?)",
+		},
+		{
+			name:    "UPDATE columns in the name order of meta.Table",
+			dialect: types.DialectPostgreSQL,
+			target: expr.Update(users, map[string]interface{}{
+				"name": "foo", "created_on": "now",
+			}).Where(expr.Equal(colUserId, 1)),
+			qs: "UPDATE users SET created_on = ?, name = ? WHERE users.id = ?",
+		},
+		{
+			name:    "INSERT columns are not reordered",
+			dialect: types.DialectMySQL,
+			target: &grammar.InsertStatement{
+				TableName: "users",
+				Columns:   []string{"name", "id"},
+				Values:    []interface{}{"foo", 1},
+			},
+			qs: "INSERT INTO users (name, id) VALUES (?, ?)",
+		},
+		{
+			name:    "UPDATE columns are not reordered",
+			dialect: types.DialectMySQL,
+			target: &grammar.UpdateStatementSearched{
+				TableName: "users",
+				Columns:   []string{"name", "created_on"},
+				Values:    []interface{}{"foo", "now"},
+			},
+			qs: "UPDATE users SET name = ?, created_on = ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, qs, err := builder.Fingerprint(
+				tt.target, types.WithDialect(tt.dialect),
+			)
+			require.Nil(t, err)
+			assert.Equal(t, tt.qs, qs)
+			assert.Len(t, hash, 16)
+		})
+	}
+}
+
+func TestFingerprintStable(t *testing.T) {
+	assert := assert.New(t)
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	query := func(name string, ids ...interface{}) *expr.Selection {
+		return expr.Select(colUserName, articles.C("id")).
+			Where(expr.And(
+				expr.In(colUserId, ids...),
+				expr.Equal(colUserName, name),
+			))
+	}
+	insert := func(id int) *expr.InsertStatement {
+		return expr.Insert(users, map[string]interface{}{
+			"id": id, "name": "foo", "created_on": "now",
+		})
+	}
+
+	wantHash, wantQs, err := builder.Fingerprint(query("foo", 1))
+	require.Nil(t, err)
+	wantInsHash, _, err := builder.Fingerprint(insert(1))
+	require.Nil(t, err)
+	for x := 0; x < 50; x++ {
+		hash, qs, err := builder.Fingerprint(query("bar", 1, 2, x))
+		require.Nil(t, err)
+		assert.Equal(wantHash, hash)
+		assert.Equal(wantQs, qs)
+
+		hash, _, err = builder.Fingerprint(insert(x))
+		require.Nil(t, err)
+		assert.Equal(wantInsHash, hash)
+	}
+
+	// Formatting options do not change the fingerprint
+	hash, _, err := builder.Fingerprint(
+		query("foo", 1),
+		types.WithFormatPretty("  "),
+		types.WithFormatKeywordCase(types.KeywordCaseLower),
+	)
+	require.Nil(t, err)
+	assert.Equal(wantHash, hash)
+
+	// A different query has a different fingerprint
+	hash, _, err = builder.Fingerprint(expr.Select(colUserName))
+	require.Nil(t, err)
+	assert.NotEqual(wantHash, hash)
+}
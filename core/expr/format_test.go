@@ -7,6 +7,7 @@
 package expr_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/jaypipes/sqlb/core/expr"
@@ -90,3 +91,186 @@ LIMIT ?`,
 		})
 	}
 }
+
+func TestFormatPretty(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserName := users.C("name")
+	colUserId := users.C("id")
+	colArticleId := articles.C("id")
+	colArticleAuthor := articles.C("author")
+
+	joined := expr.Select(colArticleId, colUserName.As("author"))
+	joined.Join(articles, expr.Equal(colUserId, colArticleAuthor))
+	joined.Where(
+		expr.And(
+			expr.Equal(colUserName, "foo"),
+			expr.GreaterThan(colUserId, 1),
+		),
+	)
+	joined.GroupBy(colUserName)
+	joined.OrderBy(colUserName.Desc())
+	joined.Limit(10)
+
+	u := expr.Select(colUserId, colUserName).
+		Where(expr.Equal(colUserName, "foo")).
+		As("u")
+	derived := expr.Select(u.C("name")).
+		Where(expr.NotEqual(u.C("id"), 2))
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		opts    []types.Option
+		query   *expr.Selection
+		qs      string
+	}{
+		{
+			name:  "select list, join and conjuncts",
+			opts:  []types.Option{types.WithFormatPretty("  ")},
+			query: joined,
+			qs: `SELECT
+  articles.id,
+  users.name AS author
+FROM users
+JOIN articles ON users.id = articles.author
+WHERE users.name = ?
+  AND users.id > ?
+GROUP BY users.name
+ORDER BY users.name DESC
+LIMIT ?`,
+		},
+		{
+			name:  "derived table with tab indent",
+			opts:  []types.Option{types.WithFormatPretty("\t")},
+			query: derived,
+			qs: "SELECT\n" +
+				"\tu.name\n" +
+				"FROM (\n" +
+				"\tSELECT\n" +
+				"\t\tusers.id,\n" +
+				"\t\tusers.name\n" +
+				"\tFROM users\n" +
+				"\tWHERE users.name = ?\n" +
+				") AS u\n" +
+				"WHERE u.id <> ?",
+		},
+		{
+			name: "lower case keywords",
+			opts: []types.Option{
+				types.WithFormatPretty("  "),
+				types.WithFormatKeywordCase(types.KeywordCaseLower),
+			},
+			query: joined,
+			qs: `select
+  articles.id,
+  users.name as author
+from users
+join articles on users.id = articles.author
+where users.name = ?
+  and users.id > ?
+group by users.name
+order by users.name desc
+limit ?`,
+		},
+		{
+			name: "lower case keywords compact",
+			opts: []types.Option{
+				types.WithFormatKeywordCase(types.KeywordCaseLower),
+			},
+			query: derived,
+			qs:    "select u.name from (select users.id, users.name from users where users.name = ?) as u where u.id <> ?",
+		},
+		{
+			name:    "T-SQL TOP",
+			dialect: types.DialectTSQL,
+			opts:    []types.Option{types.WithFormatPretty("  ")},
+			query:   expr.Select(colUserName).OrderBy(colUserName).Limit(5),
+			qs: `SELECT TOP (@p1)
+  users.name
+FROM users
+ORDER BY users.name`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			opts := append(
+				[]types.Option{types.WithDialect(tt.dialect)}, tt.opts...,
+			)
+			b := builder.New(opts...)
+			qs, qargs := b.StringArgs(tt.query.Query())
+			assert.Equal(tt.qs, qs)
+
+			// The formatted SQL must be semantically identical to the
+			// compact form: only whitespace and keyword case may differ.
+			cb := builder.New(types.WithDialect(tt.dialect))
+			cqs, cqargs := cb.StringArgs(tt.query.Query())
+			assert.Equal(normalize(cqs), normalize(qs))
+			assert.Equal(cqargs, qargs)
+		})
+	}
+}
+
+// normalize collapses the whitespace of the supplied SQL string, removes any
+// whitespace just inside parentheses and upper-cases it
+func normalize(qs string) string {
+	qs = strings.Join(strings.Fields(qs), " ")
+	qs = strings.ReplaceAll(qs, "( ", "(")
+	qs = strings.ReplaceAll(qs, " )", ")")
+	return strings.ToUpper(qs)
+}
+
+func TestFormatKeywordCaseIdentifiers(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	deleteLimited := expr.Delete(users).
+		Where(expr.Equal(colUserName, "foo")).
+		OrderBy(colUserId).
+		Limit(1000).
+		DeleteStatementSearched()
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		kc      types.KeywordCase
+		query   interface{}
+		qs      string
+	}{
+		{
+			name:    "lower case keywords with an alias matching a keyword",
+			dialect: types.DialectMySQL,
+			kc:      types.KeywordCaseLower,
+			query:   expr.Select(colUserName.As("LIMIT")).Query(),
+			qs:      "select users.name as `LIMIT` from users",
+		},
+		{
+			name:    "upper case keywords with SQLite row identifier",
+			dialect: types.DialectSQLite,
+			kc:      types.KeywordCaseUpper,
+			query:   deleteLimited,
+			qs:      "DELETE FROM users WHERE rowid IN (SELECT rowid FROM users WHERE users.name = ? ORDER BY users.id LIMIT ?)",
+		},
+		{
+			name:    "upper case keywords with T-SQL limited rows",
+			dialect: types.DialectTSQL,
+			kc:      types.KeywordCaseUpper,
+			query:   deleteLimited,
+			qs:      "WITH sqlb_limited AS (SELECT TOP (@p1) * FROM users WHERE users.name = @p2 ORDER BY users.id) DELETE FROM sqlb_limited",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New(
+				types.WithDialect(tt.dialect),
+				types.WithFormatKeywordCase(tt.kc),
+			)
+			qs, _ := b.StringArgs(tt.query)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
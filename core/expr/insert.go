@@ -0,0 +1,111 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// InsertStatement wraps a grammar.InsertStatement, adding chainable methods
+// to modify the INSERT statement.
+type InsertStatement struct {
+	is *grammar.InsertStatement
+	t  *meta.Table
+}
+
+// InsertStatement returns the object as a `*grammar.InsertStatement`
+func (s *InsertStatement) InsertStatement() *grammar.InsertStatement {
+	return s.is
+}
+
+// Insert returns an InsertStatement that produces an INSERT SQL statement for
+// the supplied Table and map, keyed by column name, of values to insert.
+//
+// Insert panics if the supplied Table is nil, no values are supplied or any
+// key in the map of values is not a column in the Table. This is intentional,
+// as we want compile-time failures for invalid SQL construction and we want
+// the result of Insert() to be chainable with other InsertStatement methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `InsertE` function which returns a checkable `error` object.
+func Insert(
+	t *meta.Table,
+	values map[string]interface{},
+) *InsertStatement {
+	s, err := InsertE(t, values)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// InsertE returns an InsertStatement that produces an INSERT SQL statement
+// for the supplied Table and map, keyed by column name, of values to insert.
+// If the supplied Table is nil or is a read-only view, no values are
+// supplied or any key in the map of values is not a column in the Table,
+// InsertE returns an error.
+func InsertE(
+	t *meta.Table,
+	values map[string]interface{},
+) (*InsertStatement, error) {
+	if t == nil {
+		return nil, types.TableRequired
+	}
+	is, err := t.Insert(values)
+	if err != nil {
+		return nil, err
+	}
+	return &InsertStatement{is: is, t: t}, nil
+}
+
+// Returning adds a RETURNING clause (an OUTPUT clause for T-SQL) to the
+// InsertStatement, returning the InsertStatement pointer to support method
+// chaining. The arguments must be either string column names or columns in
+// the target Table. Building the statement fails with an error wrapping
+// types.FeatureNotSupported for dialects, such as MySQL, that have neither a
+// RETURNING nor an OUTPUT clause.
+//
+// Returning panics if any of the supplied columns are not in the target
+// Table. This is intentional, as we want compile-time failures for invalid
+// SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `ReturningE` function which returns a checkable `error`
+// object.
+func (s *InsertStatement) Returning(
+	cols ...interface{},
+) *InsertStatement {
+	res, err := s.ReturningE(cols...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// ReturningE adds a RETURNING clause (an OUTPUT clause for T-SQL) to the
+// InsertStatement, returning the InsertStatement pointer to support method
+// chaining. If any of the supplied columns are not in the target Table,
+// ReturningE returns an error.
+func (s *InsertStatement) ReturningE(
+	cols ...interface{},
+) (*InsertStatement, error) {
+	if s == nil || s.is == nil {
+		return nil, fmt.Errorf(
+			"cannot call Returning() on a nil InsertStatement",
+		)
+	}
+	rc, err := returningClauseFromAny(s.t, cols...)
+	if err != nil {
+		return nil, err
+	}
+	s.is.Returning = rc
+	return s, nil
+}
@@ -0,0 +1,109 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertStatement(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+
+	values := map[string]interface{}{"name": "foo"}
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		version string
+		qs      string
+		err     error
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			err:     types.FeatureNotSupported,
+		},
+		{
+			name:    "SQLite before 3.35",
+			dialect: types.DialectSQLite,
+			version: "3.34.1",
+			err:     types.FeatureNotSupported,
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			version: "3.35.0",
+			qs:      "INSERT INTO users (name) VALUES (?) RETURNING id",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "INSERT INTO users (name) VALUES ($1) RETURNING id",
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "INSERT INTO users (name) OUTPUT INSERTED.id VALUES (@p1)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			q := expr.Insert(users, values).Returning(users.C("id"))
+
+			b := builder.New(
+				types.WithDialect(tt.dialect),
+				types.WithDialectVersion(tt.version),
+			)
+			qs, qargs, err := b.Build(q)
+			if tt.err != nil {
+				assert.ErrorIs(err, tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{"foo"}, qargs)
+		})
+	}
+}
+
+func TestInsertStatementErrors(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+
+	_, err := expr.InsertE(nil, map[string]interface{}{"name": "foo"})
+	assert.Equal(t, types.TableRequired, err)
+
+	_, err = expr.InsertE(users, nil)
+	assert.Equal(t, types.NoValues, err)
+
+	_, err = expr.InsertE(users, map[string]interface{}{"unknown": 1})
+	assert.Equal(t, types.UnknownColumn, err)
+
+	q := expr.Insert(users, map[string]interface{}{"name": "foo"})
+	_, err = q.ReturningE()
+	assert.NotNil(t, err)
+
+	_, err = q.ReturningE("unknown")
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, types.UnknownColumn))
+
+	_, err = q.ReturningE(articles.C("id"))
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, types.UnknownColumn))
+}
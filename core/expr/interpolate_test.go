@@ -0,0 +1,256 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingValuer is a driver.Valuer whose Value method always fails
+type failingValuer struct{}
+
+func (failingValuer) Value() (interface{}, error) {
+	return nil, errors.New("boom")
+}
+
+func TestInterpolate(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	ts := time.Date(2024, 2, 29, 13, 14, 15, 123000000, time.UTC)
+	name := "foo"
+	var nilName *string
+	// set returns an UPDATE statement setting the name column to the
+	// supplied value, since query args of any type can be supplied as the
+	// values of an UPDATE statement
+	set := func(v interface{}) *expr.UpdateStatement {
+		return expr.Update(users, map[string]interface{}{"name": v})
+	}
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		target  interface{}
+		qs      string
+	}{
+		{
+			name:    "string with quote ANSI",
+			dialect: types.DialectUnknown,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, "O'Brien")),
+			qs:      "SELECT users.id FROM users WHERE users.name = 'O''Brien'",
+		},
+		{
+			name:    "string with backslash MySQL",
+			dialect: types.DialectMySQL,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, `a\'b`)),
+			qs:      `SELECT users.id FROM users WHERE users.name = 'a\\''b'`,
+		},
+		{
+			name:    "string with backslash PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, `a\b`)),
+			qs:      `SELECT users.id FROM users WHERE users.name = 'a\b'`,
+		},
+		{
+			name:    "unicode string T-SQL",
+			dialect: types.DialectTSQL,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, "Zoë")),
+			qs:      "SELECT users.id FROM users WHERE users.name = N'Zoë'",
+		},
+		{
+			name:    "pointer",
+			dialect: types.DialectMySQL,
+			target:  set(&name),
+			qs:      "UPDATE users SET name = 'foo'",
+		},
+		{
+			name:    "nil pointer",
+			dialect: types.DialectMySQL,
+			target:  set(nilName),
+			qs:      "UPDATE users SET name = NULL",
+		},
+		{
+			name:    "nil",
+			dialect: types.DialectMySQL,
+			target:  set(nil),
+			qs:      "UPDATE users SET name = NULL",
+		},
+		{
+			name:    "bytes MySQL",
+			dialect: types.DialectMySQL,
+			target:  set([]byte{0xde, 0xad}),
+			qs:      "UPDATE users SET name = X'DEAD'",
+		},
+		{
+			name:    "bytes PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			target:  set([]byte{0xde, 0xad}),
+			qs:      `UPDATE users SET name = '\xdead'::bytea`,
+		},
+		{
+			name:    "bytes T-SQL",
+			dialect: types.DialectTSQL,
+			target:  set([]byte{0xde, 0xad}),
+			qs:      "UPDATE users SET name = 0xDEAD",
+		},
+		{
+			name:    "timestamp PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			target:  set(ts),
+			qs:      "UPDATE users SET name = TIMESTAMP '2024-02-29 13:14:15.123'",
+		},
+		{
+			name:    "timestamp SQLite",
+			dialect: types.DialectSQLite,
+			target:  set(ts),
+			qs:      "UPDATE users SET name = '2024-02-29 13:14:15.123'",
+		},
+		{
+			name:    "timestamp T-SQL",
+			dialect: types.DialectTSQL,
+			target:  set(ts),
+			qs:      "UPDATE users SET name = '2024-02-29T13:14:15.123'",
+		},
+		{
+			name:    "booleans ANSI",
+			dialect: types.DialectUnknown,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, true)),
+			qs:      "SELECT users.id FROM users WHERE users.name = TRUE",
+		},
+		{
+			name:    "booleans SQLite",
+			dialect: types.DialectSQLite,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, false)),
+			qs:      "SELECT users.id FROM users WHERE users.name = 0",
+		},
+		{
+			name:    "driver.Valuer",
+			dialect: types.DialectMySQL,
+			target:  set(sql.NullString{String: "foo", Valid: true}),
+			qs:      "UPDATE users SET name = 'foo'",
+		},
+		{
+			name:    "invalid driver.Valuer",
+			dialect: types.DialectMySQL,
+			target:  set(sql.NullInt64{}),
+			qs:      "UPDATE users SET name = NULL",
+		},
+		{
+			name:    "named parameter",
+			dialect: types.DialectPostgreSQL,
+			target:  expr.Select(colUserId).Where(expr.Equal(colUserName, expr.Param("name"))),
+			qs:      "SELECT users.id FROM users WHERE users.name = :name",
+		},
+		{
+			name:    "integers, LIMIT and OFFSET",
+			dialect: types.DialectPostgreSQL,
+			target: expr.Select(colUserId).
+				Where(expr.In(colUserId, int8(-1), uint(2))).
+				LimitWithOffset(10, 20),
+			qs: "SELECT users.id FROM users WHERE users.id IN(-1, 2) LIMIT 10 OFFSET 20",
+		},
+		{
+			name:    "float",
+			dialect: types.DialectMySQL,
+			target:  set(2.5),
+			qs:      "UPDATE users SET name = 2.5",
+		},
+		{
+			name:    "float32",
+			dialect: types.DialectMySQL,
+			target:  set(float32(0.1)),
+			qs:      "UPDATE users SET name = 0.1",
+		},
+		{
+			name:    "INSERT",
+			dialect: types.DialectMySQL,
+			target:  expr.Insert(users, map[string]interface{}{"name": "foo"}),
+			qs:      "INSERT INTO users (name) VALUES ('foo')",
+		},
+		{
+			name:    "UPDATE",
+			dialect: types.DialectMySQL,
+			target: expr.Update(users, map[string]interface{}{"name": "foo"}).
+				Where(expr.Equal(colUserId, 1)),
+			qs: "UPDATE users SET name = 'foo' WHERE users.id = 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qs, err := builder.Interpolate(tt.target, types.WithDialect(tt.dialect))
+			require.Nil(t, err)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	set := func(v interface{}) *expr.UpdateStatement {
+		return expr.Update(users, map[string]interface{}{"name": v})
+	}
+
+	tests := []struct {
+		name   string
+		target interface{}
+		err    error
+	}{
+		{
+			name:   "unsupported target",
+			target: "SELECT 1",
+			err:    types.UnsupportedBuildTarget,
+		},
+		{
+			name:   "NaN",
+			target: set(math.NaN()),
+			err:    types.UnsupportedInlineArg,
+		},
+		{
+			name:   "struct",
+			target: set(struct{}{}),
+			err:    types.UnsupportedInlineArg,
+		},
+		{
+			name:   "failing driver.Valuer",
+			target: set(failingValuer{}),
+			err:    types.UnsupportedInlineArg,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := builder.Interpolate(tt.target)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestWithInlineArgs(t *testing.T) {
+	assert := assert.New(t)
+	m := testutil.M()
+	users := m.T("users")
+
+	b := builder.New(types.WithInlineArgs())
+	qs, qargs := b.StringArgs(
+		expr.Select(users.C("id")).Where(expr.Equal(users.C("name"), "foo")).Query(),
+	)
+	assert.Equal("SELECT users.id FROM users WHERE users.name = 'foo'", qs)
+	assert.Empty(qargs)
+}
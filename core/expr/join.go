@@ -8,8 +8,11 @@ package expr
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
 	"github.com/jaypipes/sqlb/internal/inspect"
 	"github.com/samber/lo"
 )
@@ -81,11 +84,88 @@ func (s *Selection) OuterJoin(
 	return s.doJoin(grammar.JoinTypeLeftOuter, right, on)
 }
 
+// RightJoin adapts the Selection after right-joining the FromClause's last
+// TableReference to the first parameter which must be convertible to a
+// TableReference.
+//
+// SQLite only supports RIGHT JOIN from version 3.39. When the builder is
+// informed of an earlier SQLite version using WithDialectVersion, the right
+// join is output as a LEFT JOIN with its operands swapped.
+func (s *Selection) RightJoin(
+	rightAny interface{},
+	onAny interface{},
+) *Selection {
+	if s.qs == nil {
+		panic("attempt to join against nil query specification")
+	}
+	if len(s.qs.TableExpression.From.TableReferences) == 0 {
+		msg := "attempt to join against nothing. before calling RightJoin() " +
+			"first call Select()"
+		panic(msg)
+	}
+	right := inspect.TableReferenceFromAny(rightAny)
+	if right == nil {
+		msg := fmt.Sprintf(
+			"attempted join on invalid type %s(%T)",
+			rightAny, rightAny,
+		)
+		panic(msg)
+	}
+	on := inspect.BooleanValueExpressionFromAny(onAny)
+	if on == nil {
+		msg := fmt.Sprintf(
+			"invalid join condition %s(%T)",
+			onAny, onAny,
+		)
+		panic(msg)
+	}
+	return s.doJoin(grammar.JoinTypeRightOuter, right, on)
+}
+
+// FullJoin adapts the Selection after full-outer-joining the FromClause's
+// last TableReference to the first parameter which must be convertible to a
+// TableReference.
+//
+// MySQL and SQLite before version 3.39 have no FULL OUTER JOIN, so building
+// the Selection for those dialects returns an error wrapping
+// types.FeatureNotSupported.
+func (s *Selection) FullJoin(
+	rightAny interface{},
+	onAny interface{},
+) *Selection {
+	if s.qs == nil {
+		panic("attempt to join against nil query specification")
+	}
+	if len(s.qs.TableExpression.From.TableReferences) == 0 {
+		msg := "attempt to join against nothing. before calling FullJoin() " +
+			"first call Select()"
+		panic(msg)
+	}
+	right := inspect.TableReferenceFromAny(rightAny)
+	if right == nil {
+		msg := fmt.Sprintf(
+			"attempted join on invalid type %s(%T)",
+			rightAny, rightAny,
+		)
+		panic(msg)
+	}
+	on := inspect.BooleanValueExpressionFromAny(onAny)
+	if on == nil {
+		msg := fmt.Sprintf(
+			"invalid join condition %s(%T)",
+			onAny, onAny,
+		)
+		panic(msg)
+	}
+	return s.doJoin(grammar.JoinTypeFullOuter, right, on)
+}
+
 func (s *Selection) doJoin(
 	joinType grammar.JoinType,
 	right *grammar.TableReference,
 	on *grammar.BooleanValueExpression,
 ) *Selection {
+	s = s.modifiable()
 	// We have to remove all referenced TablePrimary TableReferences from the
 	// existing QuerySpecification's list of TableReferences because these
 	// named TablePrimaries will be output when the builder returns the
@@ -259,3 +339,158 @@ func (s *Selection) doJoin(
 	s.qs.TableExpression.From.TableReferences = updatedTRefs
 	return s
 }
+
+// JoinFK adapts the Selection after joining the supplied Table to the
+// Selection using an ON condition inferred from the foreign key between the
+// supplied Table and one of the tables already in the Selection.
+//
+// For example, if the articles.author column has a foreign key referencing
+// the users.id column, the following:
+//
+// sel := Select(users.C("name"), articles.C("title")).JoinFK(articles)
+//
+// produces this SQL:
+//
+// SELECT users.name, articles.title FROM users JOIN articles ON articles.author = users.id
+//
+// JoinFK panics if there is no foreign key between the supplied Table and the
+// tables in the Selection or if there is more than one such foreign key. This
+// is intentional, as we want compile-time failures for invalid SQL
+// construction and we want the result of JoinFK() to be chainable with other
+// Selection methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `JoinFKE` function which returns a checkable `error` object.
+func (s *Selection) JoinFK(
+	other *meta.Table,
+) *Selection {
+	res, err := s.JoinFKE(other)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// JoinFKE adapts the Selection after joining the supplied Table to the
+// Selection using an ON condition inferred from the foreign key between the
+// supplied Table and one of the tables already in the Selection. If there is
+// no foreign key between the supplied Table and the tables in the Selection,
+// an error wrapping types.NoForeignKey is returned. If there is more than one
+// such foreign key, an error wrapping types.AmbiguousForeignKey is returned.
+func (s *Selection) JoinFKE(
+	other *meta.Table,
+) (*Selection, error) {
+	if s == nil || s.qs == nil {
+		return nil, fmt.Errorf(
+			"cannot call JoinFK() on a nil QuerySpecification",
+		)
+	}
+	if other == nil {
+		return nil, types.TableRequired
+	}
+	if other.Meta() == nil {
+		return nil, fmt.Errorf(
+			"cannot call JoinFK() with table '%s' having no metadata",
+			other.Name(),
+		)
+	}
+	type candidate struct {
+		name string
+		on   *grammar.BooleanValueExpression
+	}
+	candidates := []candidate{}
+	prims := inspect.TablePrimaries(s.qs.TableExpression.From.TableReferences)
+	for _, tp := range prims {
+		if tp.TableName == nil {
+			continue
+		}
+		var left *meta.Table
+		if tp.SchemaName != nil {
+			left = other.Meta().Schema(*tp.SchemaName).T(*tp.TableName)
+		} else {
+			left = other.Meta().T(*tp.TableName)
+		}
+		if left == nil {
+			continue
+		}
+		if tp.Correlation != nil {
+			left = left.As(tp.Correlation.Name)
+		}
+		if left.AliasOrName() == other.AliasOrName() {
+			continue
+		}
+		for _, fk := range left.ForeignKeys() {
+			if fk.References(other) {
+				on, err := foreignKeyCondition(fk, left, other)
+				if err != nil {
+					return nil, err
+				}
+				candidates = append(candidates, candidate{fk.Name, on})
+			}
+		}
+		for _, fk := range other.ForeignKeys() {
+			if fk.References(left) {
+				on, err := foreignKeyCondition(fk, other, left)
+				if err != nil {
+					return nil, err
+				}
+				candidates = append(candidates, candidate{fk.Name, on})
+			}
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf(
+			"%w: table '%s' has no foreign key to or from any table in "+
+				"the Selection",
+			types.NoForeignKey, other.Name(),
+		)
+	case 1:
+		return s.doJoin(
+			grammar.JoinTypeInner, other.TableReference(), candidates[0].on,
+		), nil
+	}
+	names := make([]string, len(candidates))
+	for x, c := range candidates {
+		names[x] = c.name
+	}
+	return nil, fmt.Errorf(
+		"%w: table '%s' is related to the Selection by foreign keys %s. "+
+			"use Join() with an explicit ON condition instead",
+		types.AmbiguousForeignKey, other.Name(), strings.Join(names, ", "),
+	)
+}
+
+// foreignKeyCondition returns a BooleanValueExpression equating each of the
+// supplied foreign key's columns in the referencing table with the
+// corresponding column in the referenced table. If any of the foreign key's
+// columns are not known, an error is returned.
+func foreignKeyCondition(
+	fk *meta.ForeignKey,
+	referencing *meta.Table,
+	referenced *meta.Table,
+) (*grammar.BooleanValueExpression, error) {
+	if len(fk.Columns) == 0 || len(fk.Columns) != len(fk.ReferencedColumns) {
+		return nil, fmt.Errorf(
+			"foreign key '%s' has mismatched columns", fk.Name,
+		)
+	}
+	var on *grammar.BooleanValueExpression
+	for x, cname := range fk.Columns {
+		left := referencing.C(cname)
+		right := referenced.C(fk.ReferencedColumns[x])
+		if left == nil || right == nil {
+			return nil, fmt.Errorf(
+				"%w: foreign key '%s' refers to an unknown column",
+				types.UnknownColumn, fk.Name,
+			)
+		}
+		eq := inspect.BooleanValueExpressionFromAny(Equal(left, right))
+		if on == nil {
+			on = eq
+			continue
+		}
+		on = andBooleanValueExpressions(on, eq)
+	}
+	return on, nil
+}
@@ -7,12 +7,15 @@
 package expr_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/types"
 	"github.com/jaypipes/sqlb/internal/builder"
 	"github.com/jaypipes/sqlb/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestJoin(t *testing.T) {
@@ -93,7 +96,7 @@ func TestJoin(t *testing.T) {
 					colUserId, colUserProfileUser,
 				),
 			),
-			qs: "SELECT articles.id, users.name AS author, user_profiles.content AS author_profile FROM articles JOIN users ON articles.author = users.id JOIN user_profiles ON users.id = user_profiles.user",
+			qs: "SELECT articles.id, users.name AS author, user_profiles.content AS author_profile FROM articles JOIN users ON articles.author = users.id JOIN user_profiles ON users.id = user_profiles.`user`",
 		},
 		{
 			name: "LEFT JOIN with WHERE",
@@ -156,6 +159,136 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestRightJoin(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	articleStates := m.T("article_states")
+	colUserName := users.C("name")
+	colUserId := users.C("id")
+	colArticleId := articles.C("id")
+	colArticleAuthor := articles.C("author")
+	colArticleState := articles.C("state")
+	colArticleStateId := articleStates.C("id")
+
+	tests := []struct {
+		name string
+		opts []types.Option
+		q    *expr.Selection
+		qs   string
+	}{
+		{
+			name: "RIGHT JOIN",
+			q: expr.Select(colArticleId, colUserName).RightJoin(
+				users, expr.Equal(colArticleAuthor, colUserId),
+			),
+			qs: "SELECT articles.id, users.name FROM articles RIGHT JOIN users ON articles.author = users.id",
+		},
+		{
+			name: "SQLite 3.39 RIGHT JOIN",
+			opts: []types.Option{
+				types.WithDialect(types.DialectSQLite),
+				types.WithDialectVersion("3.39.0"),
+			},
+			q: expr.Select(colArticleId, colUserName).RightJoin(
+				users, expr.Equal(colArticleAuthor, colUserId),
+			),
+			qs: "SELECT articles.id, users.name FROM articles RIGHT JOIN users ON articles.author = users.id",
+		},
+		{
+			name: "SQLite 3.38 RIGHT JOIN swapped to LEFT JOIN",
+			opts: []types.Option{
+				types.WithDialect(types.DialectSQLite),
+				types.WithDialectVersion("3.38.5"),
+			},
+			q: expr.Select(colArticleId, colUserName).RightJoin(
+				users, expr.Equal(colArticleAuthor, colUserId),
+			),
+			qs: "SELECT articles.id, users.name FROM users LEFT JOIN articles ON articles.author = users.id",
+		},
+		{
+			name: "SQLite 3.38 RIGHT JOIN of a joined table",
+			opts: []types.Option{
+				types.WithDialect(types.DialectSQLite),
+				types.WithDialectVersion("3.38.5"),
+			},
+			q: expr.Select(colArticleId, colUserName).Join(
+				articleStates, expr.Equal(colArticleState, colArticleStateId),
+			).RightJoin(
+				users, expr.Equal(colArticleAuthor, colUserId),
+			),
+			qs: "SELECT articles.id, users.name FROM users LEFT JOIN (articles JOIN article_states ON articles.state = article_states.id) ON articles.author = users.id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New(tt.opts...)
+			qs, _ := b.StringArgs(tt.q.Query())
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
+func TestFullJoin(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserName := users.C("name")
+	colUserId := users.C("id")
+	colArticleId := articles.C("id")
+	colArticleAuthor := articles.C("author")
+
+	q := expr.Select(colArticleId, colUserName).FullJoin(
+		users, expr.Equal(colArticleAuthor, colUserId),
+	)
+
+	tests := []struct {
+		name string
+		opts []types.Option
+		qs   string
+		err  error
+	}{
+		{
+			name: "PostgreSQL",
+			opts: []types.Option{types.WithDialect(types.DialectPostgreSQL)},
+			qs:   "SELECT articles.id, users.name FROM articles FULL OUTER JOIN users ON articles.author = users.id",
+		},
+		{
+			name: "SQLite 3.39",
+			opts: []types.Option{
+				types.WithDialect(types.DialectSQLite),
+				types.WithDialectVersion("3.39.0"),
+			},
+			qs: "SELECT articles.id, users.name FROM articles FULL OUTER JOIN users ON articles.author = users.id",
+		},
+		{
+			name: "SQLite 3.38",
+			opts: []types.Option{
+				types.WithDialect(types.DialectSQLite),
+				types.WithDialectVersion("3.38.5"),
+			},
+			err: types.FeatureNotSupported,
+		},
+		{
+			name: "MySQL",
+			opts: []types.Option{types.WithDialect(types.DialectMySQL)},
+			err:  types.FeatureNotSupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New(tt.opts...)
+			qs, _, err := b.Build(q)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
 func TestJoinPanics(t *testing.T) {
 	m := testutil.M()
 	users := m.T("users")
@@ -350,3 +483,74 @@ func TestJoinDerivedWithMultipleSelections(t *testing.T) {
 	assert.Equal(expqs, qs)
 	assert.Equal(expqargs, qargs)
 }
+
+func TestJoinFK(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	articleStates := m.T("article_states")
+	organizations := m.T("organizations")
+	organizationUsers := m.T("organization_users")
+
+	tests := []struct {
+		name string
+		q    *expr.Selection
+		qs   string
+	}{
+		{
+			name: "referenced table in Selection",
+			q:    expr.Select(users.C("name")).JoinFK(articles),
+			qs:   "SELECT users.name FROM users JOIN articles ON articles.author = users.id",
+		},
+		{
+			name: "referencing table in Selection",
+			q:    expr.Select(articles.C("id")).JoinFK(articleStates),
+			qs:   "SELECT articles.id FROM articles JOIN article_states ON articles.state = article_states.id",
+		},
+		{
+			name: "aliased tables",
+			q: expr.Select(users.As("u").C("name")).JoinFK(
+				articles.As("a"),
+			),
+			qs: "SELECT u.name FROM users AS u JOIN articles AS a ON a.author = u.id",
+		},
+		{
+			name: "chained",
+			q: expr.Select(organizations.C("uuid")).
+				JoinFK(organizationUsers).
+				JoinFK(users),
+			qs: "SELECT organizations.uuid FROM organizations JOIN organization_users ON organization_users.organization_id = organizations.id JOIN users ON organization_users.user_id = users.id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New()
+			qs, _ := b.StringArgs(tt.q.Query())
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
+func TestJoinFKErrors(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articleStates := m.T("article_states")
+	organizations := m.T("organizations")
+	organizationUsers := m.T("organization_users")
+
+	_, err := expr.Select(users.C("name")).JoinFKE(articleStates)
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, types.NoForeignKey))
+	assert.Contains(t, err.Error(), "article_states")
+
+	_, err = expr.Select(
+		users.C("name"), organizations.C("uuid"),
+	).JoinFKE(organizationUsers)
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, types.AmbiguousForeignKey))
+	assert.Contains(t, err.Error(), "fk_organization_users_users")
+	assert.Contains(t, err.Error(), "fk_organization_users_organizations")
+
+	_, err = expr.Select(users.C("name")).JoinFKE(nil)
+	assert.Equal(t, types.TableRequired, err)
+}
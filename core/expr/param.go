@@ -0,0 +1,22 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr
+
+import "github.com/jaypipes/sqlb/core/grammar"
+
+// Param returns a named parameter that can be used anywhere a value is
+// accepted, e.g. `Equal(users.C("id"), Param("id"))` or as the value of an
+// UPDATE's SET clause.
+//
+// A named parameter is output as the Dialect's placeholder, like any other
+// query arg. Its value is supplied later, when a Template compiled from the
+// statement is given the parameter values with `Template.Args` or
+// `Template.Bind`. Building a statement containing a named parameter other
+// than with Compile returns an error wrapping types.MissingParam.
+func Param(name string) *grammar.HostParameterSpecification {
+	return &grammar.HostParameterSpecification{Name: name}
+}
@@ -0,0 +1,124 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierQuoting(t *testing.T) {
+	m := &meta.Meta{Name: "test"}
+	orders := meta.NewTable(m, "order", "id", "user", "CustomerName", "1st")
+	orders.SetSchema("Sales")
+
+	q := expr.Select(
+		orders.C("id"), orders.C("user"), orders.C("CustomerName"),
+	).Where(expr.Equal(orders.C("1st"), 1))
+
+	tests := []struct {
+		name string
+		mods []types.Option
+		qs   string
+	}{
+		{
+			name: "MySQL when needed",
+			mods: []types.Option{
+				types.WithDialect(types.DialectMySQL),
+			},
+			qs: "SELECT `order`.id, `order`.`user`, `order`.CustomerName FROM Sales.`order` WHERE `order`.`1st` = ?",
+		},
+		{
+			name: "PostgreSQL when needed",
+			mods: []types.Option{
+				types.WithDialect(types.DialectPostgreSQL),
+			},
+			qs: `SELECT "order".id, "order"."user", "order"."CustomerName" FROM "Sales"."order" WHERE "order"."1st" = $1`,
+		},
+		{
+			name: "SQLite when needed",
+			mods: []types.Option{
+				types.WithDialect(types.DialectSQLite),
+			},
+			qs: `SELECT "order".id, "order"."user", "order".CustomerName FROM Sales."order" WHERE "order"."1st" = ?`,
+		},
+		{
+			name: "TSQL when needed",
+			mods: []types.Option{
+				types.WithDialect(types.DialectTSQL),
+			},
+			qs: "SELECT [order].id, [order].[user], [order].CustomerName FROM Sales.[order] WHERE [order].[1st] = @p1",
+		},
+		{
+			name: "MySQL always",
+			mods: []types.Option{
+				types.WithDialect(types.DialectMySQL),
+				types.WithQuoteMode(types.QuoteAlways),
+			},
+			qs: "SELECT `order`.`id`, `order`.`user`, `order`.`CustomerName` FROM `Sales`.`order` WHERE `order`.`1st` = ?",
+		},
+		{
+			name: "PostgreSQL never",
+			mods: []types.Option{
+				types.WithDialect(types.DialectPostgreSQL),
+				types.WithQuoteMode(types.QuoteNever),
+			},
+			qs: "SELECT order.id, order.user, order.CustomerName FROM Sales.order WHERE order.1st = $1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			b := builder.New(tt.mods...)
+			qs, qargs := b.StringArgs(q.Query())
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{1}, qargs)
+		})
+	}
+}
+
+func TestIdentifierQuotingEscape(t *testing.T) {
+	m := &meta.Meta{Name: "test"}
+	weird := meta.NewTable(m, "we\"i`r]d", "id")
+
+	q := expr.Select(weird.C("id"))
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		qs      string
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			qs:      "SELECT `we\"i``r]d`.id FROM `we\"i``r]d`",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "SELECT \"we\"\"i`r]d\".id FROM \"we\"\"i`r]d\"",
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "SELECT [we\"i`r]]d].id FROM [we\"i`r]]d]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, _ := b.StringArgs(q.Query())
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
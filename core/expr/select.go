@@ -25,6 +25,9 @@ type Selection struct {
 	cs    *grammar.CursorSpecification
 	cols  []types.Projection
 	alias string
+	// immutable is true if the methods that modify the Selection modify and
+	// return a Clone of it instead
+	immutable bool
 }
 
 func (s *Selection) Name() string {
@@ -103,6 +106,55 @@ func (s *Selection) TableReference() *grammar.TableReference {
 	}
 }
 
+// Clone returns a deep copy of the Selection. Modifying the copy, e.g. with
+// Where or OrderBy, leaves the Selection unchanged, and vice versa. The copy
+// is immutable if the Selection is.
+func (s *Selection) Clone() *Selection {
+	if s == nil {
+		return nil
+	}
+	c := &Selection{
+		cols:      slices.Clone(s.cols),
+		alias:     s.alias,
+		immutable: s.immutable,
+	}
+	if s.cs != nil {
+		// The CursorSpecification wraps the QuerySpecification, so the
+		// cloned CursorSpecification wraps the cloned QuerySpecification
+		c.cs = s.cs.Clone()
+		c.qs = c.cs.Query.Body.NonJoin.NonJoin.Primary.Simple.QuerySpecification
+	} else {
+		c.qs = s.qs.Clone()
+	}
+	return c
+}
+
+// Immutable returns a copy of the Selection in immutable mode. The methods of
+// an immutable Selection that would modify it, like Where, Join or OrderBy,
+// leave it untouched and return a modified copy of it instead. This allows a
+// base query to be extended in different ways:
+//
+//	base := expr.Select(users).Immutable()
+//	byName := base.Where(expr.Equal(users.C("name"), "foo"))
+//	newest := base.OrderBy(users.C("created_on").Desc()).Limit(10)
+func (s *Selection) Immutable() *Selection {
+	c := s.Clone()
+	if c != nil {
+		c.immutable = true
+	}
+	return c
+}
+
+// modifiable returns the Selection that a method modifying the Selection
+// should modify and return: the Selection itself, or a Clone of it if the
+// Selection is immutable
+func (s *Selection) modifiable() *Selection {
+	if s.immutable {
+		return s.Clone()
+	}
+	return s
+}
+
 // C returns a pointer to a Column with a name matching the supplied string, or
 // nil if no such column is known
 //
@@ -150,7 +202,18 @@ func SelectE(
 ) (*Selection, error) {
 	cols := []types.Projection{}
 	sels := []grammar.SelectSublist{}
+	// trefByName and trefNames together hold the table references for the
+	// FROM clause. trefNames records the order in which each table was first
+	// referenced so that the FROM clause is output in the same order every
+	// time.
 	trefByName := map[string]grammar.TableReference{}
+	trefNames := []string{}
+	addTref := func(name string, tref grammar.TableReference) {
+		if _, found := trefByName[name]; !found {
+			trefNames = append(trefNames, name)
+		}
+		trefByName[name] = tref
+	}
 	nDerived := 0
 	// For each scannable item we've received in the call, check what concrete
 	// type they are and, depending on which type they are, either add them to
@@ -198,7 +261,7 @@ func SelectE(
 				name: derivedName,
 			}
 			tref := grammar.TableReference{Primary: &tp}
-			trefByName[derivedName] = tref
+			addTref(derivedName, tref)
 			// We need to project all columns from the supplied Selection's
 			// QuerySpecification to the outer QuerySpecification.
 			for _, c := range item.Projections() {
@@ -271,7 +334,7 @@ func SelectE(
 		case types.Relation:
 			tname := item.AliasOrName()
 			tr := item.TableReference()
-			trefByName[tname] = *tr
+			addTref(tname, *tr)
 			for _, p := range item.Projections() {
 				dc := p.DerivedColumn()
 				sels = append(sels, grammar.SelectSublist{DerivedColumn: dc})
@@ -285,7 +348,7 @@ func SelectE(
 			if ref != nil {
 				tname := ref.AliasOrName()
 				tr := ref.TableReference()
-				trefByName[tname] = *tr
+				addTref(tname, *tr)
 			}
 		default:
 			// Everything else, make it a general literal value projection, so, for
@@ -318,9 +381,9 @@ func SelectE(
 		)
 	}
 
-	trefs := make([]grammar.TableReference, 0, len(trefByName))
-	for _, tref := range trefByName {
-		trefs = append(trefs, tref)
+	trefs := make([]grammar.TableReference, 0, len(trefNames))
+	for _, name := range trefNames {
+		trefs = append(trefs, trefByName[name])
 	}
 	return &Selection{
 		qs: &grammar.QuerySpecification{
@@ -389,6 +452,7 @@ func (s *Selection) CountE() (*Selection, error) {
 	if s == nil || s.qs == nil {
 		return nil, fmt.Errorf("called Count() on a nil Selection.")
 	}
+	s = s.modifiable()
 	dc := grammar.DerivedColumn{
 		Value: grammar.ValueExpression{
 			Row: &grammar.RowValueExpression{
@@ -435,6 +499,7 @@ func (s *Selection) LimitE(count int) (*Selection, error) {
 			"cannot call Limit() on a nil Selection",
 		)
 	}
+	s = s.modifiable()
 	if s.cs != nil {
 		s.cs.Limit = &grammar.LimitClause{
 			Count: count,
@@ -502,6 +567,7 @@ func (s *Selection) LimitWithOffsetE(
 			"cannot call LimitWithOffset() on a nil Selection",
 		)
 	}
+	s = s.modifiable()
 	if s.cs != nil {
 		s.cs.Limit = &grammar.LimitClause{
 			Count:  count,
@@ -570,22 +636,12 @@ func (s *Selection) WhereE(
 			"cannot call Where() on a nil QuerySpecification",
 		)
 	}
-	bve := inspect.BooleanValueExpressionFromAny(exprAny)
-	if bve == nil {
-		return nil, fmt.Errorf(
-			"could not convert %s(%T) to expected BooleanValueExpression",
-			exprAny, exprAny,
-		)
-	}
-	te := &s.qs.TableExpression
-	if te.Where != nil {
-		te.Where.Search = *And(&te.Where.Search, bve)
-	} else {
-		te.Where = &grammar.WhereClause{
-			Search: *bve,
-		}
+	where, err := whereClauseFromAny(s.qs.TableExpression.Where, exprAny)
+	if err != nil {
+		return nil, err
 	}
-	s.qs.TableExpression = *te
+	s = s.modifiable()
+	s.qs.TableExpression.Where = where
 	return s, nil
 }
 
@@ -621,6 +677,7 @@ func (s *Selection) GroupByE(
 			"cannot call GroupBy() on a nil QuerySpecification",
 		)
 	}
+	s = s.modifiable()
 	te := &s.qs.TableExpression
 	if te.GroupBy == nil {
 		te.GroupBy = &grammar.GroupByClause{}
@@ -692,9 +749,14 @@ func (s *Selection) HavingE(
 			exprAny, exprAny,
 		)
 	}
+	s = s.modifiable()
 	te := &s.qs.TableExpression
 	if te.Having != nil {
-		te.Having.Search = *And(&te.Having.Search, bve)
+		// The new HAVING clause refers to the search condition of the
+		// existing one, which must therefore be left unchanged
+		te.Having = &grammar.HavingClause{
+			Search: *andBooleanValueExpressions(&te.Having.Search, bve),
+		}
 	} else {
 		te.Having = &grammar.HavingClause{
 			Search: *bve,
@@ -734,6 +796,11 @@ func (s *Selection) OrderByE(
 			"cannot call OrderBy() on a nil Selection",
 		)
 	}
+	specs, err := sortSpecificationsFromAny(specAnys...)
+	if err != nil {
+		return nil, err
+	}
+	s = s.modifiable()
 	if s.cs == nil {
 		s.cs = &grammar.CursorSpecification{
 			Query: grammar.QueryExpression{
@@ -751,6 +818,23 @@ func (s *Selection) OrderByE(
 			},
 		}
 	}
+	if s.cs.OrderBy == nil {
+		s.cs.OrderBy = &grammar.OrderByClause{
+			SortSpecifications: []grammar.SortSpecification{},
+		}
+	}
+	s.cs.OrderBy.SortSpecifications = append(
+		s.cs.OrderBy.SortSpecifications, specs...,
+	)
+	return s, nil
+}
+
+// sortSpecificationsFromAny returns a slice of SortSpecifications from the
+// supplied arguments, which must be SortSpecifications or be coercible to
+// ValueExpressions.
+func sortSpecificationsFromAny(
+	specAnys ...interface{},
+) ([]grammar.SortSpecification, error) {
 	specs := []grammar.SortSpecification{}
 	for _, specAny := range specAnys {
 		switch v := specAny.(type) {
@@ -773,13 +857,5 @@ func (s *Selection) OrderByE(
 			specs = append(specs, grammar.SortSpecification{Key: *ve})
 		}
 	}
-	if s.cs.OrderBy == nil {
-		s.cs.OrderBy = &grammar.OrderByClause{
-			SortSpecifications: []grammar.SortSpecification{},
-		}
-	}
-	s.cs.OrderBy.SortSpecifications = append(
-		s.cs.OrderBy.SortSpecifications, specs...,
-	)
-	return s, nil
+	return specs, nil
 }
@@ -49,6 +49,17 @@ func TestSelect(t *testing.T) {
 			qs:    "SELECT users.id, users.name FROM users WHERE (users.name = ? OR users.name = ?)",
 			qargs: []interface{}{"foo", "bar"},
 		},
+		{
+			name: "WHERE chained after an OR",
+			q: expr.Select(colUserId, colUserName).Where(
+				expr.Or(
+					expr.Equal(colUserId, 1),
+					expr.Equal(colUserId, 2),
+				),
+			).Where(expr.Equal(colUserName, "foo")),
+			qs:    "SELECT users.id, users.name FROM users WHERE (users.id = ? OR users.id = ?) AND users.name = ?",
+			qargs: []interface{}{1, 2, "foo"},
+		},
 		{
 			name: "Simple GROUP BY",
 			q:    expr.Select(colUserId, colUserName).GroupBy(colUserName),
@@ -60,6 +71,17 @@ func TestSelect(t *testing.T) {
 			qs:    "SELECT users.id, users.name FROM users HAVING users.name = ?",
 			qargs: []interface{}{"foo"},
 		},
+		{
+			name: "HAVING chained after an OR",
+			q: expr.Select(colUserId, colUserName).Having(
+				expr.Or(
+					expr.Equal(colUserId, 1),
+					expr.Equal(colUserId, 2),
+				),
+			).Having(expr.Equal(colUserName, "foo")),
+			qs:    "SELECT users.id, users.name FROM users HAVING (users.id = ? OR users.id = ?) AND users.name = ?",
+			qargs: []interface{}{1, 2, "foo"},
+		},
 		{
 			name: "Simple ORDER BY",
 			q:    expr.Select(colUserId, colUserName).OrderBy(colUserName.Desc()),
@@ -124,3 +146,152 @@ func TestModifyingSelectQueryUpdatesBuffer(t *testing.T) {
 	assert.Equal("SELECT users.id, users.name FROM users WHERE users.id = ?", qs)
 	assert.Equal([]interface{}{1}, qargs)
 }
+
+func TestSelectFromOrderIsDeterministic(t *testing.T) {
+	assert := assert.New(t)
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	articleStates := m.T("article_states")
+	sub := expr.Select(users.C("id")).As("u")
+
+	// The FROM clause lists relations in the order they were first
+	// referenced in the Select() call, on every build
+	tests := []struct {
+		items []interface{}
+		qs    string
+	}{
+		{
+			items: []interface{}{users.C("id"), articles.C("id"), articleStates.C("id")},
+			qs:    "SELECT users.id, articles.id, article_states.id FROM users, articles, article_states",
+		},
+		{
+			items: []interface{}{articleStates.C("id"), articles.C("id"), users.C("id"), articles.C("state")},
+			qs:    "SELECT article_states.id, articles.id, users.id, articles.state FROM article_states, articles, users",
+		},
+		{
+			items: []interface{}{articles.C("id"), sub, users.C("name")},
+			qs:    "SELECT articles.id, u.id, users.name FROM articles, (SELECT users.id FROM users) AS u, users",
+		},
+	}
+	for _, tt := range tests {
+		for x := 0; x < 100; x++ {
+			b := builder.New()
+			qs, _ := b.StringArgs(expr.Select(tt.items...).Query())
+			assert.Equal(tt.qs, qs)
+		}
+	}
+}
+
+func TestSelectionClone(t *testing.T) {
+	assert := assert.New(t)
+	m := testutil.M()
+	users := m.T("users")
+	colUserName := users.C("name")
+	colUserId := users.C("id")
+
+	q := expr.Select(colUserId, colUserName).
+		Where(expr.Equal(colUserName, "foo")).
+		OrderBy(colUserName).
+		Limit(10)
+	c := q.Clone()
+
+	// Modifying the clone leaves the original unchanged, even when the
+	// modification reaches the wrapped QuerySpecification through the
+	// CursorSpecification added by OrderBy and Limit
+	c.Where(expr.Equal(colUserId, 1)).OrderBy(colUserId).LimitWithOffset(5, 10)
+	qs, qargs := builder.New().StringArgs(c.Query())
+	assert.Equal("SELECT users.id, users.name FROM users WHERE users.name = ? AND users.id = ? ORDER BY users.name, users.id LIMIT ? OFFSET ?", qs)
+	assert.Equal([]interface{}{"foo", 1, 5, 10}, qargs)
+
+	qs, qargs = builder.New().StringArgs(q.Query())
+	assert.Equal("SELECT users.id, users.name FROM users WHERE users.name = ? ORDER BY users.name LIMIT ?", qs)
+	assert.Equal([]interface{}{"foo", 10}, qargs)
+
+	// And vice versa
+	q.Where(expr.Equal(colUserId, 2))
+	qs, _ = builder.New().StringArgs(c.Query())
+	assert.Equal("SELECT users.id, users.name FROM users WHERE users.name = ? AND users.id = ? ORDER BY users.name, users.id LIMIT ? OFFSET ?", qs)
+
+	var nilq *expr.Selection
+	assert.Nil(nilq.Clone())
+}
+
+func TestImmutableSelection(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserName := users.C("name")
+	colUserId := users.C("id")
+
+	base := expr.Select(colUserId, colUserName).
+		Where(expr.Equal(colUserName, "foo")).
+		Immutable()
+	baseqs := "SELECT users.id, users.name FROM users WHERE users.name = ?"
+
+	tests := []struct {
+		name string
+		q    func() *expr.Selection
+		qs   string
+	}{
+		{
+			name: "Where",
+			q:    func() *expr.Selection { return base.Where(expr.Equal(colUserId, 1)) },
+			qs:   "SELECT users.id, users.name FROM users WHERE users.name = ? AND users.id = ?",
+		},
+		{
+			name: "Join",
+			q: func() *expr.Selection {
+				return base.Join(articles, expr.Equal(colUserId, articles.C("author")))
+			},
+			qs: "SELECT users.id, users.name FROM users JOIN articles ON users.id = articles.author WHERE users.name = ?",
+		},
+		{
+			name: "GroupBy and Having",
+			q: func() *expr.Selection {
+				return base.GroupBy(colUserName).Having(expr.Equal(colUserName, "bar"))
+			},
+			qs: "SELECT users.id, users.name FROM users WHERE users.name = ? GROUP BY users.name HAVING users.name = ?",
+		},
+		{
+			name: "OrderBy and Limit",
+			q:    func() *expr.Selection { return base.OrderBy(colUserName.Desc()).Limit(10) },
+			qs:   "SELECT users.id, users.name FROM users WHERE users.name = ? ORDER BY users.name DESC LIMIT ?",
+		},
+		{
+			name: "LimitWithOffset",
+			q:    func() *expr.Selection { return base.LimitWithOffset(10, 20) },
+			qs:   "SELECT users.id, users.name FROM users WHERE users.name = ? LIMIT ? OFFSET ?",
+		},
+		{
+			name: "Count",
+			q:    func() *expr.Selection { return base.Count() },
+			qs:   "SELECT COUNT(*) FROM users WHERE users.name = ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			q := tt.q()
+			assert.NotSame(base, q)
+			qs, _ := builder.New().StringArgs(q.Query())
+			assert.Equal(tt.qs, qs)
+
+			// The result of a method of an immutable Selection is immutable
+			// too
+			q.Where(expr.Equal(colUserId, 2))
+			qs, _ = builder.New().StringArgs(q.Query())
+			assert.Equal(tt.qs, qs)
+
+			qs, _ = builder.New().StringArgs(base.Query())
+			assert.Equal(baseqs, qs)
+		})
+	}
+
+	// A failed modification leaves the immutable Selection unchanged
+	_, err := base.WhereE(1)
+	assert.Error(t, err)
+	qs, _ := builder.New().StringArgs(base.Query())
+	assert.Equal(t, baseqs, qs)
+}
@@ -0,0 +1,182 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+	articles := m.T("articles")
+	colArticleId := articles.C("id")
+	colArticleAuthor := articles.C("author")
+	colArticleState := articles.C("state")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		target  interface{}
+		params  map[string]interface{}
+		qs      string
+		names   []string
+		qargs   []interface{}
+	}{
+		{
+			name:    "question mark placeholders",
+			dialect: types.DialectMySQL,
+			target: expr.Select(colUserName).
+				Where(expr.Equal(colUserId, expr.Param("id"))),
+			params: map[string]interface{}{"id": 42},
+			qs:     "SELECT users.name FROM users WHERE users.id = ?",
+			names:  []string{"id"},
+			qargs:  []interface{}{42},
+		},
+		{
+			name:    "numbered placeholders",
+			dialect: types.DialectPostgreSQL,
+			target: expr.Select(colUserName).
+				Where(expr.And(
+					expr.Equal(colUserName, expr.Param("name")),
+					expr.Between(colUserId, expr.Param("lo"), expr.Param("hi")),
+				)),
+			params: map[string]interface{}{"hi": 9, "lo": 1, "name": "foo"},
+			qs:     "SELECT users.name FROM users WHERE users.name = $1 AND users.id BETWEEN $2 AND $3",
+			names:  []string{"name", "lo", "hi"},
+			qargs:  []interface{}{"foo", 1, 9},
+		},
+		{
+			name:    "repeated param and query args",
+			dialect: types.DialectPostgreSQL,
+			target: expr.Select(colUserName, colArticleId).
+				Where(expr.Equal(colUserId, expr.Param("user"))).
+				Where(expr.Equal(colArticleState, 1)).
+				Where(expr.Equal(colArticleAuthor, expr.Param("user"))),
+			params: map[string]interface{}{"user": 7},
+			qs:     "SELECT users.name, articles.id FROM users, articles WHERE users.id = $1 AND articles.state = $2 AND articles.author = $3",
+			names:  []string{"user"},
+			qargs:  []interface{}{7, 1, 7},
+		},
+		{
+			name:    "UPDATE values",
+			dialect: types.DialectMySQL,
+			target: expr.Update(users, map[string]interface{}{
+				"name": expr.Param("name"),
+			}).Where(expr.Equal(colUserId, expr.Param("id"))),
+			params: map[string]interface{}{"id": 3, "name": "bar"},
+			qs:     "UPDATE users SET name = ? WHERE users.id = ?",
+			names:  []string{"name", "id"},
+			qargs:  []interface{}{"bar", 3},
+		},
+		{
+			name:    "no params",
+			dialect: types.DialectMySQL,
+			target:  expr.Select(colUserName).Where(expr.Equal(colUserId, 1)),
+			params:  map[string]interface{}{},
+			qs:      "SELECT users.name FROM users WHERE users.id = ?",
+			qargs:   []interface{}{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			tpl, err := builder.Compile(
+				tt.target, types.WithDialect(tt.dialect),
+			)
+			require.Nil(t, err)
+			assert.Equal(tt.qs, tpl.String())
+			assert.Equal(tt.names, tpl.Params())
+
+			qargs, err := tpl.Args(tt.params)
+			require.Nil(t, err)
+			assert.Equal(tt.qargs, qargs)
+		})
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	users := testutil.T("users")
+
+	_, err := builder.Compile(
+		expr.Select(users.C("name")).
+			Where(expr.In(users.C("id"))),
+	)
+	assert.ErrorIs(t, err, types.EmptyInList)
+}
+
+func TestTemplateArgsErrors(t *testing.T) {
+	users := testutil.T("users")
+	tpl, err := builder.Compile(
+		expr.Select(users.C("name")).
+			Where(expr.And(
+				expr.Equal(users.C("id"), expr.Param("id")),
+				expr.Equal(users.C("name"), expr.Param("name")),
+			)),
+	)
+	require.Nil(t, err)
+
+	_, err = tpl.Args(map[string]interface{}{"id": 1})
+	assert.ErrorIs(t, err, types.MissingParam)
+	assert.ErrorContains(t, err, "name")
+
+	_, err = tpl.Args(map[string]interface{}{
+		"id": 1, "name": "foo", "limit": 10, "email": "foo@example.com",
+	})
+	assert.ErrorIs(t, err, types.UnknownParam)
+	assert.ErrorContains(t, err, "email, limit")
+}
+
+func TestTemplateBind(t *testing.T) {
+	assert := assert.New(t)
+	users := testutil.T("users")
+	tpl, err := builder.Compile(
+		expr.Update(users, map[string]interface{}{
+			"name": expr.Param("name"),
+		}).Where(expr.Equal(users.C("id"), expr.Param("userId"))),
+	)
+	require.Nil(t, err)
+
+	type Audit struct {
+		CreatedOn string
+	}
+	type User struct {
+		Audit
+		ID   int `db:"userId"`
+		Name string
+		Bio  string
+	}
+
+	u := User{ID: 5, Name: "foo", Bio: "ignored"}
+	qargs, err := tpl.Bind(u)
+	require.Nil(t, err)
+	assert.Equal([]interface{}{"foo", 5}, qargs)
+
+	qargs, err = tpl.Bind(&u)
+	require.Nil(t, err)
+	assert.Equal([]interface{}{"foo", 5}, qargs)
+
+	_, err = tpl.Bind(struct{ Name string }{"foo"})
+	assert.ErrorIs(err, types.MissingParam)
+	assert.ErrorContains(err, "userId")
+
+	_, err = tpl.Bind(map[string]interface{}{"name": "foo", "userId": 5})
+	assert.ErrorIs(err, types.InvalidBindSource)
+
+	var nilUser *User
+	_, err = tpl.Bind(nilUser)
+	assert.ErrorIs(err, types.InvalidBindSource)
+}
@@ -0,0 +1,155 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool(
+	"update", false, "update the golden files in testdata/",
+)
+
+// assertGolden compares the supplied SQL string and query args against the
+// contents of the golden file testdata/<dir>/<name>.golden, rewriting the
+// golden file instead when the -update flag is passed to go test.
+func assertGolden(
+	t *testing.T,
+	dir string,
+	name string,
+	qs string,
+	qargs []interface{},
+) {
+	t.Helper()
+	got := fmt.Sprintf("%s\n-- args: %v\n", qs, qargs)
+	path := filepath.Join("testdata", dir, name+".golden")
+	if *updateGolden {
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.Nil(t, os.WriteFile(path, []byte(got), 0o644))
+	}
+	want, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, string(want), got)
+}
+
+func TestTSQLGolden(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	userProfiles := m.T("user_profiles")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+	colArticleId := articles.C("id")
+	colArticleAuthor := articles.C("author")
+	colUserProfileContent := userProfiles.C("content")
+	colUserProfileUser := userProfiles.C("user")
+
+	orderDetails := meta.NewTable(m, "order details", "id", "quantity]")
+
+	tests := []struct {
+		name string
+		q    interface{}
+	}{
+		{
+			name: "select_top",
+			q:    expr.Select(colUserId, colUserName).Limit(10).Query(),
+		},
+		{
+			name: "select_top_order_by",
+			q: expr.Select(colUserId, colUserName).
+				OrderBy(colUserName.Desc()).
+				Limit(10).
+				Query(),
+		},
+		{
+			name: "select_top_where",
+			q: expr.Select(colUserId).
+				Where(expr.Equal(colUserName, "foo")).
+				Limit(10).
+				Query(),
+		},
+		{
+			name: "select_offset_fetch",
+			q: expr.Select(colUserId, colUserName).
+				OrderBy(colUserId).
+				LimitWithOffset(10, 20).
+				Query(),
+		},
+		{
+			name: "select_offset_fetch_no_order_by",
+			q: expr.Select(colUserId).
+				Where(expr.GreaterThan(colUserId, 100)).
+				LimitWithOffset(10, 20).
+				Query(),
+		},
+		{
+			name: "select_where_markers",
+			q: expr.Select(colArticleId).
+				Join(users, expr.Equal(colArticleAuthor, colUserId)).
+				Where(
+					expr.And(
+						expr.Equal(colUserName, "foo"),
+						expr.In(colArticleId, 1, 2, 3),
+					),
+				).
+				Query(),
+		},
+		{
+			name: "select_quoted_identifiers",
+			q: expr.Select(
+				colUserProfileUser,
+				colUserProfileContent.As("order"),
+			).Query(),
+		},
+		{
+			name: "select_quoted_table",
+			q: expr.Select(
+				orderDetails.C("id"),
+				orderDetails.C("quantity]"),
+			).Query(),
+		},
+		{
+			name: "insert",
+			q: expr.Insert(
+				userProfiles, map[string]interface{}{"user": 1},
+			).Returning(userProfiles.C("id")).InsertStatement(),
+		},
+		{
+			name: "update_top",
+			q: expr.Update(
+				users, map[string]interface{}{"name": "bar"},
+			).Where(
+				expr.Equal(colUserName, "foo"),
+			).OrderBy(colUserId).Limit(5).UpdateStatementSearched(),
+		},
+		{
+			name: "delete",
+			q: expr.Delete(userProfiles).Where(
+				expr.Equal(colUserProfileUser, 1),
+			).DeleteStatementSearched(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New(types.WithDialect(types.DialectTSQL))
+			qs, qargs := b.StringArgs(tt.q)
+			assertGolden(t, "tsql", tt.name, qs, qargs)
+		})
+	}
+}
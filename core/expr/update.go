@@ -0,0 +1,406 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/inspect"
+)
+
+// UpdateStatement wraps a grammar.UpdateStatementSearched, adding chainable
+// methods to modify the UPDATE statement.
+type UpdateStatement struct {
+	us *grammar.UpdateStatementSearched
+	t  *meta.Table
+}
+
+// UpdateStatementSearched returns the object as a
+// `*grammar.UpdateStatementSearched`
+func (s *UpdateStatement) UpdateStatementSearched() *grammar.UpdateStatementSearched {
+	return s.us
+}
+
+// Update returns an UpdateStatement that produces an UPDATE SQL statement for
+// the supplied Table and map, keyed by column name, of values to update. Call
+// the Where() method on the returned UpdateStatement to restrict the rows
+// that are updated.
+//
+// Update panics if the supplied Table is nil, no values are supplied or any
+// key in the map of values is not a column in the Table. This is intentional,
+// as we want compile-time failures for invalid SQL construction and we want
+// the result of Update() to be chainable with other UpdateStatement methods.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `UpdateE` function which returns a checkable `error` object.
+func Update(
+	t *meta.Table,
+	values map[string]interface{},
+) *UpdateStatement {
+	s, err := UpdateE(t, values)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// UpdateE returns an UpdateStatement that produces an UPDATE SQL statement
+// for the supplied Table and map, keyed by column name, of values to update.
+// If the supplied Table is nil or is a read-only view, no values are
+// supplied or any key in the map of values is not a column in the Table,
+// UpdateE returns an error.
+func UpdateE(
+	t *meta.Table,
+	values map[string]interface{},
+) (*UpdateStatement, error) {
+	if t == nil {
+		return nil, types.TableRequired
+	}
+	us, err := t.UpdateAll(values)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateStatement{us: us, t: t}, nil
+}
+
+// Update returns an UpdateStatement that produces a multi-table UPDATE SQL
+// statement. The supplied target Table must be one of the tables referenced
+// in the Selection's FROM clause. Any tables joined to the target via the
+// Selection's Join() method are used to determine the rows in the target
+// table to update, and the Selection's WHERE clause is used as the UPDATE
+// statement's search condition.
+//
+// For example, the following:
+//
+// sel := Select(users).Join(articles, Equal(users.C("id"), articles.C("author")))
+// sel.Where(Equal(articles.C("state"), 1))
+// stmt := sel.Update(users, map[string]interface{}{"name": "foo"})
+//
+// produces this SQL for MySQL:
+//
+// UPDATE users JOIN articles ON users.id = articles.author SET users.name = ? WHERE articles.state = ?
+//
+// and this SQL for PostgreSQL:
+//
+// UPDATE users SET name = $1 FROM articles WHERE users.id = articles.author AND articles.state = $2
+//
+// Update panics if the Selection cannot be converted into a multi-table
+// UPDATE statement. This is intentional, as we want compile-time failures for
+// invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `UpdateE` function which returns a checkable `error` object.
+func (s *Selection) Update(
+	target *meta.Table,
+	values map[string]interface{},
+) *UpdateStatement {
+	us, err := s.UpdateE(target, values)
+	if err != nil {
+		panic(err)
+	}
+	return us
+}
+
+// UpdateE returns an UpdateStatement that produces a multi-table UPDATE SQL
+// statement. The supplied target Table must be one of the tables referenced
+// in the Selection's FROM clause. If the Selection cannot be converted into a
+// multi-table UPDATE statement, UpdateE returns an error.
+func (s *Selection) UpdateE(
+	target *meta.Table,
+	values map[string]interface{},
+) (*UpdateStatement, error) {
+	if target == nil {
+		return nil, types.TableRequired
+	}
+	trefs, err := s.targetTableReferences(target, "Update")
+	if err != nil {
+		return nil, err
+	}
+	us, err := target.UpdateAll(values)
+	if err != nil {
+		return nil, err
+	}
+	us.From = trefs
+	us.Where = s.qs.TableExpression.Where
+	return &UpdateStatement{us: us, t: target}, nil
+}
+
+// targetTableReferences returns the Selection's FROM clause table references
+// after ensuring that they may be used as the table references of a
+// multi-table UPDATE or DELETE statement against the supplied target table.
+// If the Selection only references the target table, the returned slice is
+// nil.
+func (s *Selection) targetTableReferences(
+	target *meta.Table,
+	caller string,
+) ([]grammar.TableReference, error) {
+	if s == nil || s.qs == nil {
+		return nil, fmt.Errorf(
+			"cannot call %s() on a nil QuerySpecification",
+			caller,
+		)
+	}
+	if s.cs != nil {
+		return nil, fmt.Errorf(
+			"cannot call %s() on a Selection having an ORDER BY or LIMIT",
+			caller,
+		)
+	}
+	trefs := s.qs.TableExpression.From.TableReferences
+	prims, _, ok := inspect.InnerJoinedTablePrimaries(trefs)
+	if !ok {
+		return nil, fmt.Errorf(
+			"cannot call %s() on a Selection containing joins other "+
+				"than inner joins",
+			caller,
+		)
+	}
+	tp := inspect.TablePrimaryByTableName(prims, target.Name())
+	if tp == nil {
+		return nil, fmt.Errorf(
+			"target table '%s' is not referenced in the Selection",
+			target.Name(),
+		)
+	}
+	if target.Alias() != "" && (tp.Correlation == nil || tp.Correlation.Name != target.Alias()) {
+		return nil, fmt.Errorf(
+			"target table '%s' is not referenced in the Selection "+
+				"with alias '%s'",
+			target.Name(), target.Alias(),
+		)
+	}
+	if len(prims) == 1 {
+		return nil, nil
+	}
+	return trefs, nil
+}
+
+// Where adapts the UpdateStatement with a filtering expression, returning the
+// UpdateStatement pointer to support method chaining. If the UpdateStatement
+// already has a filtering expression, the supplied expression is ANDed with
+// it.
+//
+// Where panics if the supplied parameter cannot be converted to a
+// BooleanValueExpression. This is intentional, as we want compile-time
+// failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `WhereE` function which returns a checkable `error` object.
+func (s *UpdateStatement) Where(
+	exprAny interface{},
+) *UpdateStatement {
+	res, err := s.WhereE(exprAny)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// WhereE adapts the UpdateStatement with a filtering expression, returning
+// the UpdateStatement pointer to support method chaining. If the supplied
+// parameter cannot be converted to a BooleanValueExpression, WhereE returns
+// an error.
+func (s *UpdateStatement) WhereE(
+	exprAny interface{},
+) (*UpdateStatement, error) {
+	if s == nil || s.us == nil {
+		return nil, fmt.Errorf(
+			"cannot call Where() on a nil UpdateStatement",
+		)
+	}
+	wc, err := whereClauseFromAny(s.us.Where, exprAny)
+	if err != nil {
+		return nil, err
+	}
+	s.us.Where = wc
+	return s, nil
+}
+
+// And ANDs the supplied filtering expression with the UpdateStatement's
+// existing filtering expression, returning the UpdateStatement pointer to
+// support method chaining.
+//
+// And panics if the UpdateStatement has no filtering expression yet or the
+// supplied parameter cannot be converted to a BooleanValueExpression. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `AndE` function which returns a checkable `error` object.
+func (s *UpdateStatement) And(
+	exprAny interface{},
+) *UpdateStatement {
+	res, err := s.AndE(exprAny)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// AndE ANDs the supplied filtering expression with the UpdateStatement's
+// existing filtering expression, returning the UpdateStatement pointer to
+// support method chaining. If the UpdateStatement has no filtering expression
+// yet or the supplied parameter cannot be converted to a
+// BooleanValueExpression, AndE returns an error.
+func (s *UpdateStatement) AndE(
+	exprAny interface{},
+) (*UpdateStatement, error) {
+	if s == nil || s.us == nil {
+		return nil, fmt.Errorf(
+			"cannot call And() on a nil UpdateStatement",
+		)
+	}
+	if s.us.Where == nil {
+		return nil, fmt.Errorf("cannot call And() before calling Where()")
+	}
+	return s.WhereE(exprAny)
+}
+
+// Returning adds a RETURNING clause (an OUTPUT clause for T-SQL) to the
+// UpdateStatement, returning the UpdateStatement pointer to support method
+// chaining. The arguments must be either string column names or columns in
+// the target Table. Building the statement fails with an error wrapping
+// types.FeatureNotSupported for dialects, such as MySQL, that have neither a
+// RETURNING nor an OUTPUT clause. When the statement joins the target Table
+// to other tables, the returned columns are qualified with the target Table's
+// name or alias.
+//
+// Returning panics if any of the supplied columns are not in the target
+// Table. This is intentional, as we want compile-time failures for invalid
+// SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `ReturningE` function which returns a checkable `error`
+// object.
+func (s *UpdateStatement) Returning(
+	cols ...interface{},
+) *UpdateStatement {
+	res, err := s.ReturningE(cols...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// ReturningE adds a RETURNING clause (an OUTPUT clause for T-SQL) to the
+// UpdateStatement, returning the UpdateStatement pointer to support method
+// chaining. If any of the supplied columns are not in the target Table,
+// ReturningE returns an error.
+func (s *UpdateStatement) ReturningE(
+	cols ...interface{},
+) (*UpdateStatement, error) {
+	if s == nil || s.us == nil {
+		return nil, fmt.Errorf(
+			"cannot call Returning() on a nil UpdateStatement",
+		)
+	}
+	rc, err := returningClauseFromAny(s.t, cols...)
+	if err != nil {
+		return nil, err
+	}
+	s.us.Returning = rc
+	return s, nil
+}
+
+// OrderBy adds an ORDER BY clause to a single-table UpdateStatement,
+// returning the UpdateStatement pointer to support method chaining. The ORDER
+// BY clause determines which rows are updated when combined with Limit().
+//
+// OrderBy panics if the supplied parameters cannot be converted to
+// SortSpecifications or the UpdateStatement is a multi-table UPDATE. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `OrderByE` function which returns a checkable `error`
+// object.
+func (s *UpdateStatement) OrderBy(
+	specAnys ...interface{},
+) *UpdateStatement {
+	res, err := s.OrderByE(specAnys...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// OrderByE adds an ORDER BY clause to a single-table UpdateStatement,
+// returning the UpdateStatement pointer to support method chaining. If the
+// supplied parameters cannot be converted to SortSpecifications or the
+// UpdateStatement is a multi-table UPDATE, OrderByE returns an error.
+func (s *UpdateStatement) OrderByE(
+	specAnys ...interface{},
+) (*UpdateStatement, error) {
+	if s == nil || s.us == nil {
+		return nil, fmt.Errorf(
+			"cannot call OrderBy() on a nil UpdateStatement",
+		)
+	}
+	if len(s.us.From) > 0 {
+		return nil, fmt.Errorf(
+			"cannot call OrderBy() on a multi-table UPDATE",
+		)
+	}
+	specs, err := sortSpecificationsFromAny(specAnys...)
+	if err != nil {
+		return nil, err
+	}
+	if s.us.OrderBy == nil {
+		s.us.OrderBy = &grammar.OrderByClause{
+			SortSpecifications: []grammar.SortSpecification{},
+		}
+	}
+	s.us.OrderBy.SortSpecifications = append(
+		s.us.OrderBy.SortSpecifications, specs...,
+	)
+	return s, nil
+}
+
+// Limit limits the number of rows updated by a single-table UpdateStatement,
+// returning the UpdateStatement pointer to support method chaining. For
+// MySQL, this is output as a LIMIT clause. For PostgreSQL and SQLite, the
+// rows to update are selected by row identifier in an ordered, limited
+// subquery. For T-SQL, the rows to update are selected with TOP in a common
+// table expression.
+//
+// Limit panics if the UpdateStatement is a multi-table UPDATE. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `LimitE` function which returns a checkable `error` object.
+func (s *UpdateStatement) Limit(
+	count int,
+) *UpdateStatement {
+	res, err := s.LimitE(count)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// LimitE limits the number of rows updated by a single-table UpdateStatement,
+// returning the UpdateStatement pointer to support method chaining. If the
+// UpdateStatement is a multi-table UPDATE, LimitE returns an error.
+func (s *UpdateStatement) LimitE(
+	count int,
+) (*UpdateStatement, error) {
+	if s == nil || s.us == nil {
+		return nil, fmt.Errorf(
+			"cannot call Limit() on a nil UpdateStatement",
+		)
+	}
+	if len(s.us.From) > 0 {
+		return nil, fmt.Errorf(
+			"cannot call Limit() on a multi-table UPDATE",
+		)
+	}
+	s.us.Limit = &grammar.LimitClause{
+		Count: count,
+	}
+	return s, nil
+}
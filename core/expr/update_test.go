@@ -0,0 +1,363 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectionUpdate(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+	colArticleAuthor := articles.C("author")
+	colArticleState := articles.C("state")
+
+	values := map[string]interface{}{"name": "foo"}
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		qs      string
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			qs:      "UPDATE users JOIN articles ON users.id = articles.author SET users.name = ? WHERE articles.state = ?",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "UPDATE users SET name = $1 FROM articles WHERE users.id = articles.author AND articles.state = $2",
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			qs:      "UPDATE users SET name = ? FROM articles WHERE users.id = articles.author AND articles.state = ?",
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "UPDATE users SET name = @p1 FROM users JOIN articles ON users.id = articles.author WHERE articles.state = @p2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			sel := expr.Select(colUserId).Join(
+				articles, expr.Equal(colUserId, colArticleAuthor),
+			).Where(expr.Equal(colArticleState, 1))
+			q := sel.Update(users, values).UpdateStatementSearched()
+
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, qargs := b.StringArgs(q)
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{"foo", 1}, qargs)
+		})
+	}
+}
+
+func TestSelectionUpdateSQLiteVersion(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+
+	sel := expr.Select(colUserId).Join(
+		articles, expr.Equal(colUserId, articles.C("author")),
+	)
+	q := sel.Update(users, map[string]interface{}{"name": "foo"})
+
+	// UPDATE ... FROM is only supported from SQLite 3.33
+	b := builder.New(
+		types.WithDialect(types.DialectSQLite),
+		types.WithDialectVersion("3.30.0"),
+	)
+	_, _, err := b.Build(q)
+	assert.ErrorIs(t, err, types.FeatureNotSupported)
+
+	b = builder.New(
+		types.WithDialect(types.DialectSQLite),
+		types.WithDialectVersion("3.33.0"),
+	)
+	qs, _, err := b.Build(q)
+	require.Nil(t, err)
+	assert.Equal(
+		t,
+		"UPDATE users SET name = ? FROM articles WHERE users.id = articles.author",
+		qs,
+	)
+}
+
+func TestSelectionUpdateReturning(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	u := m.T("users").As("u")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		target  *meta.Table
+		qs      string
+	}{
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			target:  users,
+			qs:      "UPDATE users SET name = $1 FROM articles WHERE users.id = articles.author RETURNING users.id",
+		},
+		{
+			name:    "PostgreSQL aliased target",
+			dialect: types.DialectPostgreSQL,
+			target:  u,
+			qs:      "UPDATE users AS u SET name = $1 FROM articles WHERE u.id = articles.author RETURNING u.id",
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			target:  users,
+			qs:      "UPDATE users SET name = ? FROM articles WHERE users.id = articles.author RETURNING users.id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Both tables have an id column, so the RETURNING column is
+			// qualified with the target table
+			sel := expr.Select(tt.target.C("id")).Join(
+				articles, expr.Equal(tt.target.C("id"), articles.C("author")),
+			)
+			q := sel.Update(tt.target, map[string]interface{}{"name": "foo"}).
+				Returning("id")
+
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, _, err := b.Build(q)
+			require.Nil(t, err)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
+func TestSelectionUpdateErrors(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+	colArticleAuthor := articles.C("author")
+
+	values := map[string]interface{}{"name": "foo"}
+
+	_, err := expr.Select(colArticleAuthor).UpdateE(users, values)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not referenced")
+
+	_, err = expr.Select(colUserId).OuterJoin(
+		articles, expr.Equal(colUserId, colArticleAuthor),
+	).UpdateE(users, values)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "inner joins")
+
+	_, err = expr.Select(colUserId).Join(
+		articles, expr.Equal(colUserId, colArticleAuthor),
+	).UpdateE(users, map[string]interface{}{"unknown": 1})
+	assert.Equal(t, types.UnknownColumn, err)
+}
+
+func TestUpdateOrderByLimit(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	values := map[string]interface{}{"name": "bar"}
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		qs      string
+		qargs   []interface{}
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			qs:      "UPDATE users SET name = ? WHERE users.name = ? ORDER BY users.id DESC LIMIT ?",
+			qargs:   []interface{}{"bar", "foo", 10},
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "UPDATE users SET name = $1 WHERE ctid IN (SELECT ctid FROM users WHERE users.name = $2 ORDER BY users.id DESC LIMIT $3)",
+			qargs:   []interface{}{"bar", "foo", 10},
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "WITH sqlb_limited AS (SELECT TOP (@p1) * FROM users WHERE users.name = @p2 ORDER BY users.id DESC) UPDATE sqlb_limited SET name = @p3",
+			qargs:   []interface{}{10, "foo", "bar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			q := expr.Update(users, values).
+				Where(expr.Equal(colUserName, "foo")).
+				OrderBy(colUserId.Desc()).
+				Limit(10).
+				UpdateStatementSearched()
+
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, qargs := b.StringArgs(q)
+			assert.Equal(tt.qs, qs)
+			assert.Equal(tt.qargs, qargs)
+		})
+	}
+}
+
+func TestUpdateStatement(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	values := map[string]interface{}{"name": "bar"}
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		version string
+		qs      string
+		err     error
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			err:     types.FeatureNotSupported,
+		},
+		{
+			name:    "SQLite before 3.35",
+			dialect: types.DialectSQLite,
+			version: "3.34.1",
+			err:     types.FeatureNotSupported,
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			version: "3.35.0",
+			qs:      "UPDATE users SET name = ? WHERE users.name = ? AND users.id > ? RETURNING id, name",
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			qs:      "UPDATE users SET name = $1 WHERE users.name = $2 AND users.id > $3 RETURNING id, name",
+		},
+		{
+			name:    "TSQL",
+			dialect: types.DialectTSQL,
+			qs:      "UPDATE users SET name = @p1 OUTPUT INSERTED.id, INSERTED.name WHERE users.name = @p2 AND users.id > @p3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			q := expr.Update(users, values).
+				Where(expr.Equal(colUserName, "foo")).
+				And(expr.GreaterThan(colUserId, 10)).
+				Returning("id", colUserName)
+
+			b := builder.New(
+				types.WithDialect(tt.dialect),
+				types.WithDialectVersion(tt.version),
+			)
+			qs, qargs, err := b.Build(q)
+			if tt.err != nil {
+				assert.ErrorIs(err, tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(tt.qs, qs)
+			assert.Equal([]interface{}{"bar", "foo", 10}, qargs)
+		})
+	}
+}
+
+func TestUpdateStatementErrors(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserName := users.C("name")
+
+	values := map[string]interface{}{"name": "bar"}
+
+	_, err := expr.UpdateE(nil, values)
+	assert.Equal(t, types.TableRequired, err)
+
+	_, err = expr.UpdateE(users, nil)
+	assert.Equal(t, types.NoValues, err)
+
+	q := expr.Update(users, values)
+	_, err = q.AndE(expr.Equal(colUserName, "foo"))
+	assert.NotNil(t, err)
+
+	_, err = q.WhereE(1)
+	assert.NotNil(t, err)
+
+	_, err = q.ReturningE("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestSelectionUpdateWhereUnchanged(t *testing.T) {
+	assert := assert.New(t)
+
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	colUserId := users.C("id")
+	colArticleAuthor := articles.C("author")
+	colArticleState := articles.C("state")
+
+	sel := expr.Select(colUserId).Join(
+		articles, expr.Equal(colUserId, colArticleAuthor),
+	).Where(expr.Equal(colArticleState, 1))
+	sel.Update(users, map[string]interface{}{"name": "foo"}).
+		Where(expr.Equal(colUserId, 2))
+
+	b := builder.New()
+	qs, _ := b.StringArgs(sel.Query())
+	assert.Equal("SELECT users.id FROM users JOIN articles ON users.id = articles.author WHERE articles.state = ?", qs)
+}
+
+func TestUpdateStatementWhereOr(t *testing.T) {
+	assert := assert.New(t)
+
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	q := expr.Update(users, map[string]interface{}{"name": "bar"}).
+		Where(expr.Or(
+			expr.Equal(colUserName, "foo"),
+			expr.Equal(colUserName, "baz"),
+		)).
+		And(expr.GreaterThan(colUserId, 10))
+
+	b := builder.New()
+	qs, qargs := b.StringArgs(q.UpdateStatementSearched())
+	assert.Equal("UPDATE users SET name = ? WHERE (users.name = ? OR users.name = ?) AND users.id > ?", qs)
+	assert.Equal([]interface{}{"bar", "foo", "baz", 10}, qargs)
+}
@@ -0,0 +1,258 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package fn_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/fn"
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctionDialectTranslation(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserName := users.C("name")
+	colCreatedOn := users.C("created_on")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		version string
+		p       types.Projection
+		qs      string
+	}{
+		{
+			name:    "CHAR_LENGTH MySQL",
+			dialect: types.DialectMySQL,
+			p:       fn.CharacterLength(colUserName),
+			qs:      "SELECT users.id, CHAR_LENGTH(users.name) FROM users",
+		},
+		{
+			name:    "CHAR_LENGTH USING OCTETS PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			p:       fn.CharacterLength(colUserName).Using(grammar.CharacterLengthUnitsOctets),
+			qs:      "SELECT users.id, OCTET_LENGTH(users.name) FROM users",
+		},
+		{
+			name:    "CHAR_LENGTH SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.CharacterLength(colUserName),
+			qs:      "SELECT users.id, LENGTH(users.name) FROM users",
+		},
+		{
+			name:    "CHAR_LENGTH T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.CharacterLength(colUserName),
+			qs:      "SELECT users.id, LEN(users.name) FROM users",
+		},
+		{
+			name:    "SUBSTRING PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			p:       fn.Substring(colUserName, 2).For(3),
+			qs:      "SELECT users.id, SUBSTRING(users.name FROM $1 FOR $2) FROM users",
+		},
+		{
+			name:    "SUBSTRING SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.Substring(colUserName, 2).For(3),
+			qs:      "SELECT users.id, SUBSTR(users.name, ?, ?) FROM users",
+		},
+		{
+			name:    "SUBSTRING without FOR T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Substring(colUserName, 2),
+			qs:      "SELECT users.id, SUBSTRING(users.name, @p1, 2147483647) FROM users",
+		},
+		{
+			name:    "POSITION MySQL",
+			dialect: types.DialectMySQL,
+			p:       fn.Position("x", colUserName),
+			qs:      "SELECT users.id, POSITION(? IN users.name) FROM users",
+		},
+		{
+			name:    "POSITION SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.Position("x", colUserName),
+			qs:      "SELECT users.id, INSTR(users.name, ?) FROM users",
+		},
+		{
+			name:    "POSITION T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Position("x", colUserName),
+			qs:      "SELECT users.id, CHARINDEX(@p1, users.name) FROM users",
+		},
+		{
+			name:    "EXTRACT T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Extract(colCreatedOn, fn.ExtractFieldYear),
+			qs:      "SELECT users.id, DATEPART(YEAR, users.created_on) FROM users",
+		},
+		{
+			name:    "TRIM LEADING SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.LTrim(colUserName, "x"),
+			qs:      "SELECT users.id, LTRIM(users.name, ?) FROM users",
+		},
+		{
+			name:    "TRIM LEADING T-SQL 2022",
+			dialect: types.DialectTSQL,
+			version: "16.0.1000.6",
+			p:       fn.LTrim(colUserName, "x"),
+			qs:      "SELECT users.id, TRIM(LEADING @p1 FROM users.name) FROM users",
+		},
+		{
+			name:    "TRIM T-SQL 2019",
+			dialect: types.DialectTSQL,
+			version: "15.0.2000.5",
+			p:       fn.Trim(colUserName, "x", grammar.TrimSpecificationBoth),
+			qs:      "SELECT users.id, TRIM(@p1 FROM users.name) FROM users",
+		},
+		{
+			name:    "TRIM SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.TrimSpace(colUserName),
+			qs:      "SELECT users.id, TRIM(users.name) FROM users",
+		},
+		{
+			name:    "OVERLAY MySQL",
+			dialect: types.DialectMySQL,
+			p:       fn.Overlay(colUserName, "x", 2).For(1),
+			qs:      "SELECT users.id, INSERT(users.name, ?, ?, ?) FROM users",
+		},
+		{
+			name:    "OVERLAY T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Overlay(colUserName, "x", 2).For(1),
+			qs:      "SELECT users.id, STUFF(users.name, @p1, @p2, @p3) FROM users",
+		},
+		{
+			name:    "CURRENT_TIMESTAMP PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			p:       fn.CurrentTimestamp().Precision(3),
+			qs:      "SELECT users.id, CURRENT_TIMESTAMP(3) FROM users",
+		},
+		{
+			name:    "CURRENT_DATE SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.CurrentDate(),
+			qs:      "SELECT users.id, CURRENT_DATE FROM users",
+		},
+		{
+			name:    "CURRENT_TIMESTAMP T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.CurrentTimestamp(),
+			qs:      "SELECT users.id, GETDATE() FROM users",
+		},
+		{
+			name:    "CEIL T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Ceiling(users.C("id")),
+			qs:      "SELECT users.id, CEILING(users.id) FROM users",
+		},
+		{
+			name:    "STDDEV_POP T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Aggregate(users.C("id"), grammar.ComputationalOperationStdDevPop),
+			qs:      "SELECT users.id, STDEVP(users.id) FROM users",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := builder.New(
+				types.WithDialect(tt.dialect),
+				types.WithDialectVersion(tt.version),
+			)
+			qs, _, err := b.StringArgsE(expr.Select(users.C("id"), tt.p).Query())
+			require.Nil(t, err)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
+
+func TestFunctionDialectNotSupported(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserName := users.C("name")
+	colCreatedOn := users.C("created_on")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		version string
+		p       types.Projection
+	}{
+		{
+			name:    "SUBSTRING SIMILAR MySQL",
+			dialect: types.DialectMySQL,
+			p:       fn.RegexSubstring(colUserName, "%x%", "#"),
+		},
+		{
+			name:    "EXTRACT SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.Extract(colCreatedOn, fn.ExtractFieldYear),
+		},
+		{
+			name:    "EXTRACT TIMEZONE_HOUR T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Extract(colCreatedOn, fn.ExtractFieldTimezoneHour),
+		},
+		{
+			name:    "OVERLAY without FOR T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.Overlay(colUserName, "x", 2),
+		},
+		{
+			name:    "NORMALIZE SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.Normalize(colUserName),
+		},
+		{
+			name:    "TRIM LEADING T-SQL 2019",
+			dialect: types.DialectTSQL,
+			version: "15.0.2000.5",
+			p:       fn.LTrim(colUserName, "x"),
+		},
+		{
+			name:    "CURRENT_DATE T-SQL",
+			dialect: types.DialectTSQL,
+			p:       fn.CurrentDate(),
+		},
+		{
+			name:    "CURRENT_TIME precision SQLite",
+			dialect: types.DialectSQLite,
+			p:       fn.CurrentTime().Precision(3),
+		},
+		{
+			name:    "EVERY MySQL",
+			dialect: types.DialectMySQL,
+			p:       fn.Aggregate(users.C("id"), grammar.ComputationalOperationEvery),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := expr.Select(users.C("id"), tt.p).Query()
+
+			opts := []types.Option{
+				types.WithDialect(tt.dialect),
+				types.WithDialectVersion(tt.version),
+			}
+			b := builder.New(opts...)
+			_, _, err := b.StringArgsE(q)
+			assert.ErrorIs(t, err, types.FunctionNotSupported)
+
+			b = builder.New(opts...)
+			assert.Panics(t, func() { b.StringArgs(q) })
+		})
+	}
+}
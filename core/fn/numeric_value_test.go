@@ -115,7 +115,7 @@ func TestSelectCharacterLengthFunction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			b := builder.New()
+			b := builder.New(types.WithDialect(types.DialectUnknown))
 
 			qs, qargs := b.StringArgs(tt.q.Query())
 			assert.Equal(len(tt.qargs), len(qargs))
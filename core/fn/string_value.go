@@ -337,6 +337,95 @@ func (f *FoldFunction) As(alias string) types.Projection {
 	return f
 }
 
+// Concat returns a ConcatFunction that produces the concatenation of the
+// supplied character values that can be passed to sqlb constructs and
+// functions like Select()
+//
+// Each argument must be coercible to a character factor and there must be at
+// least two arguments. The concatenation is output using the "||" operator
+// for PostgreSQL and SQLite, the CONCAT() SQL function for MySQL and the "+"
+// operator for T-SQL.
+func Concat(
+	subjectsAny ...interface{},
+) *ConcatFunction {
+	if len(subjectsAny) < 2 {
+		panic("expected at least two values to concatenate")
+	}
+	var ref types.Relation
+	var cve *grammar.CharacterValueExpression
+	for _, subjectAny := range subjectsAny {
+		switch subjectAny := subjectAny.(type) {
+		case types.Projection:
+			if ref == nil {
+				ref = subjectAny.References()
+			}
+		}
+		subject := inspect.CharacterPrimaryFromAny(subjectAny)
+		if subject == nil {
+			msg := fmt.Sprintf(
+				"expected coerceable CharacterPrimary but got %+v(%T)",
+				subjectAny, subjectAny,
+			)
+			panic(msg)
+		}
+		factor := grammar.CharacterFactor{Primary: *subject}
+		if cve == nil {
+			cve = &grammar.CharacterValueExpression{Factor: &factor}
+			continue
+		}
+		cve = &grammar.CharacterValueExpression{
+			Concatenation: &grammar.Concatenation{
+				Left:  *cve,
+				Right: factor,
+			},
+		}
+	}
+	return &ConcatFunction{
+		BaseFunction: BaseFunction{
+			ref: ref,
+		},
+		Concatenation: cve.Concatenation,
+	}
+}
+
+// ConcatFunction wraps the concatenation of character values
+type ConcatFunction struct {
+	BaseFunction
+	*grammar.Concatenation
+}
+
+// CommonValueExpression returns the object as a
+// `*grammar.CommonValueExpression`
+func (f *ConcatFunction) CommonValueExpression() *grammar.CommonValueExpression {
+	return &grammar.CommonValueExpression{
+		String: &grammar.StringValueExpression{
+			Character: &grammar.CharacterValueExpression{
+				Concatenation: f.Concatenation,
+			},
+		},
+	}
+}
+
+// DerivedColumn returns the `*grammar.DerivedColumn` element representing
+// the Projection
+func (f *ConcatFunction) DerivedColumn() *grammar.DerivedColumn {
+	dc := &grammar.DerivedColumn{
+		Value: grammar.ValueExpression{
+			Common: f.CommonValueExpression(),
+		},
+	}
+	if f.alias != "" {
+		dc.As = &f.alias
+	}
+	return dc
+}
+
+// As aliases the concatenation as the supplied column name
+func (f *ConcatFunction) As(alias string) types.Projection {
+	f.alias = alias
+	return f
+}
+
 // Convert returns a TranscodingFunction that produces a CONVERT() SQL
 // function that can be passed to sqlb constructs and functions like Select()
 //
@@ -514,6 +603,10 @@ func (f *TransliterationFunction) As(alias string) types.Projection {
 // wish to trim from the subject. The second argument must be coercible to a
 // character value expression. The third argument specifies whether the
 // leading, trailing or both sides of the subject should be trimmed.
+//
+// T-SQL only supports trimming the leading or trailing side alone from SQL
+// Server 2022 (16.x). Building such a function for older versions fails with
+// an error wrapping types.FunctionNotSupported.
 func Trim(
 	subjectAny interface{},
 	charsAny interface{},
@@ -788,6 +881,22 @@ func (f *OverlayFunction) Using(
 	return f
 }
 
+// For modifies the OVERLAY function with the length of the portion of the
+// subject to replace. The supplied argument must be coercible into a Numeric
+// Value Expression.
+func (f *OverlayFunction) For(valAny interface{}) *OverlayFunction {
+	v := inspect.NumericValueExpressionFromAny(valAny)
+	if v == nil {
+		msg := fmt.Sprintf(
+			"expected coerceable NumericValueExpression but got %+v(%T)",
+			valAny, valAny,
+		)
+		panic(msg)
+	}
+	f.CharacterOverlayFunction.For = v
+	return f
+}
+
 // Normalize returns a NormalizeFunction that produces a NORMALIZE() SQL
 // function that can be passed to sqlb constructs and functions like Select()
 //
@@ -884,21 +993,6 @@ func Reverse(p api.Projection) api.Projection {
 	}
 }
 
-// Concat returns a Projection that contains the CONCAT() SQL function
-func Concat(projs ...api.Projection) api.Projection {
-	els := make([]api.Element, len(projs))
-	for x, p := range projs {
-		els[x] = p.(api.Element)
-	}
-	subjects := element.NewList(els...)
-	return &Function{
-		ScanInfo: grammar.FunctionScanTable(grammar.FUNC_CONCAT),
-		elements: []api.Element{subjects},
-		// TODO(jaypipes): Clearly we need to support >1 selection...
-		sel: projs[0].From(),
-	}
-}
-
 // ConcatWs returns a Projection that contains the CONCAT_WS() SQL function
 func ConcatWs(sep string, projs ...api.Projection) api.Projection {
 	els := make([]api.Element, len(projs))
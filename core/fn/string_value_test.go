@@ -312,7 +312,7 @@ func TestSelectRegexSubstringFunction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			b := builder.New()
+			b := builder.New(types.WithDialect(types.DialectUnknown))
 
 			qs, qargs := b.StringArgs(tt.q.Query())
 			assert.Equal(len(tt.qargs), len(qargs))
@@ -687,7 +687,7 @@ func TestSelectTransliterationFunction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			b := builder.New()
+			b := builder.New(types.WithDialect(types.DialectUnknown))
 
 			qs, qargs := b.StringArgs(tt.q.Query())
 			assert.Equal(len(tt.qargs), len(qargs))
@@ -1105,7 +1105,7 @@ func TestSelectOverlayFunction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			b := builder.New()
+			b := builder.New(types.WithDialect(types.DialectUnknown))
 
 			qs, qargs := b.StringArgs(tt.q.Query())
 			assert.Equal(len(tt.qargs), len(qargs))
@@ -1219,7 +1219,7 @@ func TestSelectNormalizeFunction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			b := builder.New()
+			b := builder.New(types.WithDialect(types.DialectUnknown))
 
 			qs, qargs := b.StringArgs(tt.q.Query())
 			assert.Equal(len(tt.qargs), len(qargs))
@@ -1227,3 +1227,71 @@ func TestSelectNormalizeFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectConcatFunction(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		q       *expr.Selection
+		qs      string
+		qargs   []interface{}
+	}{
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			q: expr.Select(
+				fn.Concat(colUserName, "-", colUserId).As("handle"),
+			),
+			qs:    "SELECT users.name || ? || users.id AS handle FROM users",
+			qargs: []interface{}{"-"},
+		},
+		{
+			name:    "PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			q:       expr.Select(fn.Concat(colUserName, "-", colUserId)),
+			qs:      "SELECT users.name || $1 || users.id FROM users",
+			qargs:   []interface{}{"-"},
+		},
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			q:       expr.Select(fn.Concat(colUserName, "-", colUserId)),
+			qs:      "SELECT CONCAT(users.name, ?, users.id) FROM users",
+			qargs:   []interface{}{"-"},
+		},
+		{
+			name:    "T-SQL",
+			dialect: types.DialectTSQL,
+			q:       expr.Select(fn.Concat(colUserName, colUserName)),
+			qs:      "SELECT users.name + users.name FROM users",
+			qargs:   []interface{}{},
+		},
+		{
+			name:    "in WHERE clause",
+			dialect: types.DialectSQLite,
+			q: expr.Select(colUserId).Where(
+				expr.Equal(fn.Concat(colUserName, "x"), "foox"),
+			),
+			qs:    "SELECT users.id FROM users WHERE users.name || ? = ?",
+			qargs: []interface{}{"x", "foox"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			b := builder.New(types.WithDialect(tt.dialect))
+
+			qs, qargs := b.StringArgs(tt.q.Query())
+			assert.Equal(tt.qargs, qargs)
+			assert.Equal(tt.qs, qs)
+		})
+	}
+
+	assert.Panics(t, func() { fn.Concat(colUserName) })
+}
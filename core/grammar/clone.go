@@ -0,0 +1,39 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package grammar
+
+// Clone returns a deep copy of the tree of Nodes rooted at the supplied Node.
+// Modifying the copy, e.g. with Rewrite, leaves the original unchanged.
+//
+// Every Node type also has a Clone method returning a copy of the concrete
+// type. Values of interface fields that are not Nodes, like the Value of a
+// GeneralLiteral, are copied as is.
+func Clone(node Node) Node {
+	if node == nil {
+		return nil
+	}
+	return cloneNode(node)
+}
+
+// clonePointer returns a pointer to a copy of the value the supplied pointer
+// points to, or nil if the pointer is nil
+func clonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// cloneAny returns a deep copy of the value of an interface field if it is a
+// Node, or the value unchanged otherwise
+func cloneAny(val interface{}) interface{} {
+	if n, ok := val.(Node); ok {
+		return cloneNode(n)
+	}
+	return val
+}
@@ -0,0 +1,123 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package grammar_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertNotShared fails the test if any pointer or slice reachable from the
+// supplied value a refers to the same memory as the corresponding pointer or
+// slice reachable from the supplied value b
+func assertNotShared(t *testing.T, a, b reflect.Value, path string) {
+	switch a.Kind() {
+	case reflect.Struct:
+		for x := 0; x < a.NumField(); x++ {
+			assertNotShared(
+				t, a.Field(x), b.Field(x),
+				path+"."+a.Type().Field(x).Name,
+			)
+		}
+	case reflect.Pointer:
+		if a.IsNil() {
+			return
+		}
+		// Pointers to zero-sized values, like a *struct{}, may all share
+		// the same address
+		if a.Type().Elem().Size() > 0 && a.Pointer() == b.Pointer() {
+			t.Errorf("%s is shared with the clone", path)
+			return
+		}
+		assertNotShared(t, a.Elem(), b.Elem(), path)
+	case reflect.Slice:
+		if a.Len() == 0 {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			t.Errorf("%s is shared with the clone", path)
+			return
+		}
+		for x := 0; x < a.Len(); x++ {
+			assertNotShared(t, a.Index(x), b.Index(x), path+"[]")
+		}
+	case reflect.Interface:
+		if !a.IsNil() {
+			assertNotShared(t, a.Elem(), b.Elem(), path)
+		}
+	}
+}
+
+func TestCloneEveryNode(t *testing.T) {
+	for _, n := range allNodes() {
+		typ := reflect.TypeOf(n).Elem()
+		t.Run(typ.Name(), func(t *testing.T) {
+			populate(reflect.ValueOf(n).Elem(), 2)
+
+			c := grammar.Clone(n)
+			assert.Equal(t, n, c)
+			assertNotShared(
+				t, reflect.ValueOf(n), reflect.ValueOf(c), typ.Name(),
+			)
+
+			// The Clone method of the concrete type returns the same copy
+			m := reflect.ValueOf(n).MethodByName("Clone")
+			assert.Equal(t, n, m.Call(nil)[0].Interface())
+
+			// Cloning a nil pointer returns a nil pointer of the same type
+			nilNode := reflect.Zero(reflect.TypeOf(n))
+			res := nilNode.MethodByName("Clone").Call(nil)[0]
+			assert.True(t, res.IsNil())
+		})
+	}
+	assert.Nil(t, grammar.Clone(nil))
+}
+
+func TestCloneQuery(t *testing.T) {
+	assert := assert.New(t)
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	opts := []types.Option{types.WithDialect(types.DialectPostgreSQL)}
+
+	sel := expr.Select(users.C("name"), articles.C("id")).
+		Join(articles, expr.Equal(users.C("id"), articles.C("author"))).
+		Where(expr.Equal(users.C("name"), "foo"))
+	orig := sel.QuerySpecification()
+	wantqs, wantqargs := builder.New(opts...).StringArgs(orig)
+
+	c := orig.Clone()
+	qs, qargs := builder.New(opts...).StringArgs(c)
+	assert.Equal(wantqs, qs)
+	assert.Equal(wantqargs, qargs)
+
+	// Rewriting the clone leaves the original unchanged
+	grammar.Rewrite(c, func(node grammar.Node) grammar.Node {
+		switch n := node.(type) {
+		case *grammar.WhereClause:
+			return nil
+		case *grammar.GeneralLiteral:
+			n.Value = "bar"
+		}
+		return node
+	})
+	qs, _ = builder.New(opts...).StringArgs(c)
+	assert.Equal(
+		"SELECT users.name, articles.id FROM users JOIN articles ON users.id = articles.author",
+		qs,
+	)
+	qs, qargs = builder.New(opts...).StringArgs(orig)
+	assert.Equal(wantqs, qs)
+	assert.Equal([]interface{}{"foo"}, qargs)
+}
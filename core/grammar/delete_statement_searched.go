@@ -14,12 +14,39 @@ package grammar
 
 // DeleteStatementSearched represents a DELETE FROM SQL statement
 type DeleteStatementSearched struct {
-	TableName string
-	Where     *WhereClause
+	// SchemaName is the optional name of the schema containing the table
+	// named by TableName
+	SchemaName *string
+	TableName  string
+	// Using is a non-standard extension containing the table references that
+	// the target table is joined with in a multi-table DELETE. When
+	// non-empty, it includes the target table itself. It is output as DELETE
+	// ... USING for PostgreSQL and as DELETE t FROM t JOIN ... for MySQL and
+	// T-SQL.
+	Using []TableReference
+	Where *WhereClause
+	// OrderBy is a non-standard extension that orders the rows to be
+	// deleted. It is only meaningful when combined with Limit.
+	OrderBy *OrderByClause
+	// Limit is a non-standard extension that limits the number of rows
+	// deleted.
+	Limit *LimitClause
+	// Returning is a non-standard extension containing the columns of the
+	// deleted rows to return.
+	Returning *ReturningClause
 }
 
 func (s *DeleteStatementSearched) ArgCount(count *int) {
+	for _, tref := range s.Using {
+		tref.ArgCount(count)
+	}
 	if s.Where != nil {
 		s.Where.ArgCount(count)
 	}
+	if s.OrderBy != nil {
+		s.OrderBy.ArgCount(count)
+	}
+	if s.Limit != nil {
+		s.Limit.ArgCount(count)
+	}
 }
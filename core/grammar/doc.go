@@ -8,8 +8,13 @@
 // SQL syntax along with some structs that represent various SQL language
 // extensions implemented by databases like MySQL or PostgreSQL.
 //
-// All structs in this package are *plain old data* (POD) structs. None of the
-// structs have methods and this package contains zero logic for building complex
-// SQL statements from these basic structs. Look in [core/expr] and
-// [internal/builder] packages for that.
+// All structs in this package are *plain old data* (POD) structs and this
+// package contains zero logic for building complex SQL statements from these
+// basic structs. Look in [core/expr] and [internal/builder] packages for that.
+//
+// A pointer to every struct is a [Node], and a tree of Nodes can be traversed
+// with [Walk] or [Inspect], modified with [Rewrite] and deep-copied with
+// [Clone]. The code that walks and copies each Node is generated from the
+// struct definitions: after adding a struct, or a field to a struct, run `go
+// generate ./core/grammar`.
 package grammar
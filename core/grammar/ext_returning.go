@@ -0,0 +1,17 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package grammar
+
+// RETURNING <column name> [ { <comma> <column name> }... ]
+
+// ReturningClause represents the SQL PostgreSQL/SQLite extension RETURNING
+// clause, which is output as an OUTPUT clause for T-SQL
+type ReturningClause struct {
+	Columns []string
+}
+
+func (c *ReturningClause) ArgCount(count *int) {}
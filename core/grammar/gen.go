@@ -0,0 +1,30 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+//go:build ignore
+
+// gen writes the files generated by nodegen for the grammar package in the
+// current directory. Run it with `go generate ./core/grammar`.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/jaypipes/sqlb/core/grammar/internal/nodegen"
+)
+
+func main() {
+	files, err := nodegen.Generate(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(name, contents, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -28,9 +28,15 @@ package grammar
 
 // InsertStatement represents an INSERT SQL statement
 type InsertStatement struct {
-	TableName string
-	Columns   []string
-	Values    []interface{}
+	// SchemaName is the optional name of the schema containing the table
+	// named by TableName
+	SchemaName *string
+	TableName  string
+	Columns    []string
+	Values     []interface{}
+	// Returning is a non-standard extension containing the columns of the
+	// inserted rows to return.
+	Returning *ReturningClause
 }
 
 func (s *InsertStatement) ArgCount(count *int) {
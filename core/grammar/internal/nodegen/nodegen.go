@@ -0,0 +1,409 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Package nodegen generates the code that makes every struct in the grammar
+// package a grammar.Node: the Node marker methods, the functions that walk
+// and rewrite the children of each Node, the Clone method of each Node, and
+// the list of Node types used by the grammar package's exhaustive tests.
+//
+// The generated code is derived from the struct definitions alone, so that
+// adding a struct, or a field to a struct, only requires running `go
+// generate ./core/grammar`. The grammar package's tests fail if the
+// generated files are out of date.
+package nodegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+const (
+	// CodeFile is the name of the generated file containing the Node
+	// methods and the walking, rewriting and cloning functions
+	CodeFile = "node_gen.go"
+	// TestFile is the name of the generated file containing the list of
+	// Node types for the exhaustive tests
+	TestFile = "node_gen_test.go"
+)
+
+const header = `//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Code generated by nodegen. DO NOT EDIT.
+
+`
+
+// fieldKind describes how the children of a struct field are walked and how
+// the field is copied when its struct is cloned
+type fieldKind int
+
+const (
+	// fieldValue is a field of a struct type, e.g. `A RowValuePredicand`
+	fieldValue fieldKind = iota
+	// fieldPointer is a field of a pointer to a struct type, e.g. `Where
+	// *WhereClause`
+	fieldPointer
+	// fieldValueSlice is a field of a slice of a struct type, e.g.
+	// `Sublists []SelectSublist`
+	fieldValueSlice
+	// fieldPointerSlice is a field of a slice of pointers to a struct type
+	fieldPointerSlice
+	// fieldAny is a field of an interface type, which may hold a Node, e.g.
+	// the `Value interface{}` of a literal
+	fieldAny
+	// fieldAnySlice is a field of a slice of an interface type, each
+	// element of which may hold a Node
+	fieldAnySlice
+	// fieldOtherPointer is a field of a pointer to a type that is not a
+	// struct of the grammar package, e.g. `Offset *int`. It contains no
+	// Nodes but is copied when its struct is cloned.
+	fieldOtherPointer
+	// fieldOtherSlice is a field of a slice of a type that is not a struct
+	// of the grammar package, e.g. `Identifiers []string`. It contains no
+	// Nodes but is copied when its struct is cloned.
+	fieldOtherSlice
+)
+
+// field is a struct field whose value may contain Nodes or must be copied
+// when its struct is cloned
+type field struct {
+	name string
+	kind fieldKind
+	// elem is the name of the element type of a fieldValueSlice or
+	// fieldPointerSlice field
+	elem string
+}
+
+// hasNodes returns true if the field may contain Nodes
+func (f field) hasNodes() bool {
+	return f.kind != fieldOtherPointer && f.kind != fieldOtherSlice
+}
+
+// node is a struct type of the grammar package and the fields of the struct
+// that may contain Nodes or must be copied when the struct is cloned, in
+// declaration order
+type node struct {
+	name   string
+	fields []field
+}
+
+// hasChildren returns true if any field of the struct may contain Nodes
+func (n node) hasChildren() bool {
+	for _, f := range n.fields {
+		if f.hasNodes() {
+			return true
+		}
+	}
+	return false
+}
+
+// usesSlices returns true if cloning any of the supplied structs copies a
+// slice that contains no Nodes
+func usesSlices(nodes []node) bool {
+	for _, n := range nodes {
+		for _, f := range n.fields {
+			if f.kind == fieldOtherSlice {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Generate parses the non-test Go files of the grammar package in the
+// supplied directory and returns the contents of the generated files, keyed
+// by file name
+func Generate(dir string) (map[string][]byte, error) {
+	nodes, err := parse(dir)
+	if err != nil {
+		return nil, err
+	}
+	code, err := format.Source(genCode(nodes))
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", CodeFile, err)
+	}
+	test, err := format.Source(genTest(nodes))
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", TestFile, err)
+	}
+	return map[string][]byte{
+		CodeFile: code,
+		TestFile: test,
+	}, nil
+}
+
+// parse returns the struct types declared in the grammar package in the
+// supplied directory, ordered by name
+func parse(dir string) ([]node, error) {
+	fset := token.NewFileSet()
+	nonTest := func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, nonTest, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, found := pkgs["grammar"]
+	if !found {
+		return nil, fmt.Errorf("no grammar package found in %s", dir)
+	}
+
+	structs := map[string]*ast.StructType{}
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					structs[ts.Name.Name] = st
+				}
+			}
+		}
+	}
+
+	nodes := make([]node, 0, len(structs))
+	for name, st := range structs {
+		n := node{name: name}
+		for _, f := range st.Fields.List {
+			kind, ok := classify(f.Type, structs)
+			if !ok {
+				continue
+			}
+			elem := ""
+			if kind == fieldValueSlice || kind == fieldPointerSlice {
+				elem = typeName(f.Type.(*ast.ArrayType).Elt)
+			}
+			if len(f.Names) == 0 {
+				// An embedded struct's field name is its type name
+				n.fields = append(n.fields, field{
+					name: typeName(f.Type), kind: kind,
+				})
+				continue
+			}
+			for _, fname := range f.Names {
+				n.fields = append(n.fields, field{
+					name: fname.Name, kind: kind, elem: elem,
+				})
+			}
+		}
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].name < nodes[j].name
+	})
+	return nodes, nil
+}
+
+// classify returns the kind of a field of the supplied type, or false if a
+// field of the type neither contains Nodes nor needs copying when its struct
+// is cloned, e.g. a string or an enum
+func classify(
+	expr ast.Expr,
+	structs map[string]*ast.StructType,
+) (fieldKind, bool) {
+	isStruct := func(expr ast.Expr) bool {
+		id, ok := expr.(*ast.Ident)
+		return ok && structs[id.Name] != nil
+	}
+	isAny := func(expr ast.Expr) bool {
+		if id, ok := expr.(*ast.Ident); ok {
+			return id.Name == "any"
+		}
+		it, ok := expr.(*ast.InterfaceType)
+		return ok && len(it.Methods.List) == 0
+	}
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		if isStruct(t.X) {
+			return fieldPointer, true
+		}
+		return fieldOtherPointer, true
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+		if isStruct(t.Elt) {
+			return fieldValueSlice, true
+		}
+		if star, ok := t.Elt.(*ast.StarExpr); ok && isStruct(star.X) {
+			return fieldPointerSlice, true
+		}
+		if isAny(t.Elt) {
+			return fieldAnySlice, true
+		}
+		return fieldOtherSlice, true
+	default:
+		if isStruct(t) {
+			return fieldValue, true
+		}
+		if isAny(t) {
+			return fieldAny, true
+		}
+	}
+	return 0, false
+}
+
+// typeName returns the name of the type of an embedded field or of the
+// elements of a slice field
+func typeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	return expr.(*ast.Ident).Name
+}
+
+// genCode returns the unformatted contents of the generated code file
+func genCode(nodes []node) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package grammar\n\n")
+	if usesSlices(nodes) {
+		b.WriteString("import \"slices\"\n\n")
+	}
+
+	for _, n := range nodes {
+		fmt.Fprintf(&b, "func (*%s) node() {}\n", n.name)
+	}
+
+	b.WriteString(`
+// walkChildren walks each child of the supplied Node with the supplied
+// Visitor, in the order of the fields of the Node's struct
+func walkChildren(node Node, v Visitor) {
+	switch n := node.(type) {
+`)
+	for _, n := range nodes {
+		if !n.hasChildren() {
+			continue
+		}
+		fmt.Fprintf(&b, "case *%s:\n", n.name)
+		for _, f := range n.fields {
+			switch f.kind {
+			case fieldValue:
+				fmt.Fprintf(&b, "Walk(&n.%s, v)\n", f.name)
+			case fieldPointer:
+				fmt.Fprintf(&b, "if n.%[1]s != nil {\nWalk(n.%[1]s, v)\n}\n", f.name)
+			case fieldValueSlice:
+				fmt.Fprintf(&b, "for x := range n.%[1]s {\nWalk(&n.%[1]s[x], v)\n}\n", f.name)
+			case fieldPointerSlice:
+				fmt.Fprintf(&b, "for _, c := range n.%s {\nif c != nil {\nWalk(c, v)\n}\n}\n", f.name)
+			case fieldAny:
+				fmt.Fprintf(&b, "walkAny(n.%s, v)\n", f.name)
+			case fieldAnySlice:
+				fmt.Fprintf(&b, "for _, c := range n.%s {\nwalkAny(c, v)\n}\n", f.name)
+			}
+		}
+	}
+	b.WriteString("}\n}\n")
+
+	b.WriteString(`
+// rewriteChildren rewrites each child of the supplied Node with the supplied
+// function, in the order of the fields of the Node's struct
+func rewriteChildren(node Node, fn func(Node) Node) {
+	switch n := node.(type) {
+`)
+	for _, n := range nodes {
+		if !n.hasChildren() {
+			continue
+		}
+		fmt.Fprintf(&b, "case *%s:\n", n.name)
+		for _, f := range n.fields {
+			switch f.kind {
+			case fieldValue:
+				fmt.Fprintf(&b, "rewriteValue(&n.%s, fn)\n", f.name)
+			case fieldPointer:
+				fmt.Fprintf(&b, "if n.%[1]s != nil {\nn.%[1]s = rewritePointer(n.%[1]s, fn)\n}\n", f.name)
+			case fieldValueSlice:
+				fmt.Fprintf(&b, "for x := range n.%[1]s {\nrewriteValue(&n.%[1]s[x], fn)\n}\n", f.name)
+			case fieldPointerSlice:
+				fmt.Fprintf(&b, "for x, c := range n.%[1]s {\nif c != nil {\nn.%[1]s[x] = rewritePointer(c, fn)\n}\n}\n", f.name)
+			case fieldAny:
+				fmt.Fprintf(&b, "n.%[1]s = rewriteAny(n.%[1]s, fn)\n", f.name)
+			case fieldAnySlice:
+				fmt.Fprintf(&b, "for x, c := range n.%[1]s {\nn.%[1]s[x] = rewriteAny(c, fn)\n}\n", f.name)
+			}
+		}
+	}
+	b.WriteString("}\n}\n")
+
+	b.WriteString(`
+// cloneNode returns a deep copy of the supplied Node
+func cloneNode(node Node) Node {
+	switch n := node.(type) {
+`)
+	for _, n := range nodes {
+		fmt.Fprintf(&b, "case *%s:\nreturn n.Clone()\n", n.name)
+	}
+	b.WriteString("}\nreturn node\n}\n")
+
+	for _, n := range nodes {
+		fmt.Fprintf(&b, `
+// Clone returns a deep copy of the %[1]s
+func (n *%[1]s) Clone() *%[1]s {
+	if n == nil {
+		return nil
+	}
+	c := &%[1]s{}
+	n.cloneInto(c)
+	return c
+}
+`, n.name)
+		fmt.Fprintf(&b, "\nfunc (n *%s) cloneInto(c *%[1]s) {\n*c = *n\n", n.name)
+		for _, f := range n.fields {
+			switch f.kind {
+			case fieldValue:
+				fmt.Fprintf(&b, "n.%[1]s.cloneInto(&c.%[1]s)\n", f.name)
+			case fieldPointer:
+				fmt.Fprintf(&b, "c.%[1]s = n.%[1]s.Clone()\n", f.name)
+			case fieldValueSlice:
+				fmt.Fprintf(&b, "if n.%[1]s != nil {\nc.%[1]s = make([]%[2]s, len(n.%[1]s))\nfor x := range n.%[1]s {\nn.%[1]s[x].cloneInto(&c.%[1]s[x])\n}\n}\n", f.name, f.elem)
+			case fieldPointerSlice:
+				fmt.Fprintf(&b, "if n.%[1]s != nil {\nc.%[1]s = make([]*%[2]s, len(n.%[1]s))\nfor x, e := range n.%[1]s {\nc.%[1]s[x] = e.Clone()\n}\n}\n", f.name, f.elem)
+			case fieldAny:
+				fmt.Fprintf(&b, "c.%[1]s = cloneAny(n.%[1]s)\n", f.name)
+			case fieldAnySlice:
+				fmt.Fprintf(&b, "if n.%[1]s != nil {\nc.%[1]s = make([]interface{}, len(n.%[1]s))\nfor x, e := range n.%[1]s {\nc.%[1]s[x] = cloneAny(e)\n}\n}\n", f.name)
+			case fieldOtherPointer:
+				fmt.Fprintf(&b, "c.%[1]s = clonePointer(n.%[1]s)\n", f.name)
+			case fieldOtherSlice:
+				fmt.Fprintf(&b, "c.%[1]s = slices.Clone(n.%[1]s)\n", f.name)
+			}
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+// genTest returns the unformatted contents of the generated test file
+func genTest(nodes []node) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString(`package grammar_test
+
+import "github.com/jaypipes/sqlb/core/grammar"
+
+// allNodes returns a zero value of every Node type in the grammar package
+func allNodes() []grammar.Node {
+	return []grammar.Node{
+`)
+	for _, n := range nodes {
+		fmt.Fprintf(&b, "&grammar.%s{},\n", n.name)
+	}
+	b.WriteString("}\n}\n")
+	return b.Bytes()
+}
@@ -0,0 +1,4330 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Code generated by nodegen. DO NOT EDIT.
+
+package grammar
+
+import "slices"
+
+func (*AbsoluteValueExpression) node()                {}
+func (*AddIntervalExpression) node()                  {}
+func (*AddSubtractDatetimeExpression) node()          {}
+func (*AddSubtractExpression) node()                  {}
+func (*AddSubtractIntervalExpression) node()          {}
+func (*AggregateFunction) node()                      {}
+func (*BetweenPredicate) node()                       {}
+func (*BinarySetFunction) node()                      {}
+func (*BlobFactor) node()                             {}
+func (*BlobPositionExpression) node()                 {}
+func (*BlobPrimary) node()                            {}
+func (*BlobValueExpression) node()                    {}
+func (*BlobValueFunction) node()                      {}
+func (*BooleanFactor) node()                          {}
+func (*BooleanPredicand) node()                       {}
+func (*BooleanPrimary) node()                         {}
+func (*BooleanTerm) node()                            {}
+func (*BooleanTest) node()                            {}
+func (*BooleanValueExpression) node()                 {}
+func (*CardinalityExpression) node()                  {}
+func (*CeilingFunction) node()                        {}
+func (*CharacterFactor) node()                        {}
+func (*CharacterLengthExpression) node()              {}
+func (*CharacterOverlayFunction) node()               {}
+func (*CharacterPrimary) node()                       {}
+func (*CharacterSubstringFunction) node()             {}
+func (*CharacterTransliterationFunction) node()       {}
+func (*CharacterValueExpression) node()               {}
+func (*CharacterValueFunction) node()                 {}
+func (*ColumnReference) node()                        {}
+func (*CommonValueExpression) node()                  {}
+func (*ComparisonPredicate) node()                    {}
+func (*Concatenation) node()                          {}
+func (*Correlation) node()                            {}
+func (*CrossJoin) node()                              {}
+func (*CurrentTimeFunction) node()                    {}
+func (*CurrentTimestampFunction) node()               {}
+func (*CursorSpecification) node()                    {}
+func (*DatetimeFactor) node()                         {}
+func (*DatetimePrimary) node()                        {}
+func (*DatetimeTerm) node()                           {}
+func (*DatetimeValueExpression) node()                {}
+func (*DatetimeValueFunction) node()                  {}
+func (*DeleteStatementSearched) node()                {}
+func (*DerivedColumn) node()                          {}
+func (*DerivedTable) node()                           {}
+func (*EndField) node()                               {}
+func (*ExponentialFunction) node()                    {}
+func (*ExtractExpression) node()                      {}
+func (*ExtractField) node()                           {}
+func (*ExtractSource) node()                          {}
+func (*Factor) node()                                 {}
+func (*FloorFunction) node()                          {}
+func (*FoldFunction) node()                           {}
+func (*FromClause) node()                             {}
+func (*GeneralLiteral) node()                         {}
+func (*GeneralSetFunction) node()                     {}
+func (*GeneralValueSpecification) node()              {}
+func (*GroupByClause) node()                          {}
+func (*GroupingColumnReference) node()                {}
+func (*GroupingElement) node()                        {}
+func (*HavingClause) node()                           {}
+func (*HostParameterSpecification) node()             {}
+func (*IdentifierChain) node()                        {}
+func (*InPredicate) node()                            {}
+func (*InsertStatement) node()                        {}
+func (*IntersectQuery) node()                         {}
+func (*IntervalFactor) node()                         {}
+func (*IntervalPrimary) node()                        {}
+func (*IntervalQualifier) node()                      {}
+func (*IntervalTerm) node()                           {}
+func (*IntervalValueExpression) node()                {}
+func (*IntervalValueFunction) node()                  {}
+func (*JoinedTable) node()                            {}
+func (*LengthExpression) node()                       {}
+func (*LimitClause) node()                            {}
+func (*Literal) node()                                {}
+func (*LocalTimeFunction) node()                      {}
+func (*LocalTimestampFunction) node()                 {}
+func (*ModulusExpression) node()                      {}
+func (*MultiplyDivideExpression) node()               {}
+func (*MultiplyDivideIntervalTerm) node()             {}
+func (*MultiplyNumericIntervalFactor) node()          {}
+func (*NaturalJoin) node()                            {}
+func (*NaturalLogarithm) node()                       {}
+func (*NonJoinQueryExpression) node()                 {}
+func (*NonJoinQueryPrimary) node()                    {}
+func (*NonJoinQueryTerm) node()                       {}
+func (*NonParenthesizedValueExpressionPrimary) node() {}
+func (*NormalizeFunction) node()                      {}
+func (*NullPredicate) node()                          {}
+func (*NumericPrimary) node()                         {}
+func (*NumericValueExpression) node()                 {}
+func (*NumericValueFunction) node()                   {}
+func (*OctetLengthExpression) node()                  {}
+func (*OrderByClause) node()                          {}
+func (*OrderedSetFunction) node()                     {}
+func (*OrdinaryGroupingSet) node()                    {}
+func (*PositionExpression) node()                     {}
+func (*PowerFunction) node()                          {}
+func (*Predicate) node()                              {}
+func (*PrimaryDatetimeField) node()                   {}
+func (*QualifiedJoin) node()                          {}
+func (*QueryExpression) node()                        {}
+func (*QueryExpressionBody) node()                    {}
+func (*QueryPrimary) node()                           {}
+func (*QuerySpecification) node()                     {}
+func (*QueryTerm) node()                              {}
+func (*RegexSubstringFunction) node()                 {}
+func (*ReturningClause) node()                        {}
+func (*RowValueExpression) node()                     {}
+func (*RowValuePredicand) node()                      {}
+func (*SchemaQualifiedName) node()                    {}
+func (*SecondPrimaryDatetimeField) node()             {}
+func (*SelectList) node()                             {}
+func (*SelectSublist) node()                          {}
+func (*SetFunctionSpecification) node()               {}
+func (*SignedNumericLiteral) node()                   {}
+func (*SimpleTable) node()                            {}
+func (*SingleDatetimeField) node()                    {}
+func (*SortSpecification) node()                      {}
+func (*SpecificTypeFunction) node()                   {}
+func (*SquareRoot) node()                             {}
+func (*StartEndDatetimeField) node()                  {}
+func (*StartField) node()                             {}
+func (*StringPositionExpression) node()               {}
+func (*StringValueExpression) node()                  {}
+func (*StringValueFunction) node()                    {}
+func (*Subquery) node()                               {}
+func (*SubtractDatetimeExpression) node()             {}
+func (*TableExpression) node()                        {}
+func (*TablePrimary) node()                           {}
+func (*TableReference) node()                         {}
+func (*Term) node()                                   {}
+func (*TimezoneSpecifier) node()                      {}
+func (*TranscodingFunction) node()                    {}
+func (*TrimFunction) node()                           {}
+func (*TruncateTableStatement) node()                 {}
+func (*UnionJoin) node()                              {}
+func (*UnsignedLiteral) node()                        {}
+func (*UnsignedNumericLiteral) node()                 {}
+func (*UnsignedValueSpecification) node()             {}
+func (*UpdateStatementSearched) node()                {}
+func (*ValueExpression) node()                        {}
+func (*ValueExpressionPrimary) node()                 {}
+func (*ValueSpecification) node()                     {}
+func (*WhereClause) node()                            {}
+func (*WidthBucketFunction) node()                    {}
+
+// walkChildren walks each child of the supplied Node with the supplied
+// Visitor, in the order of the fields of the Node's struct
+func walkChildren(node Node, v Visitor) {
+	switch n := node.(type) {
+	case *AbsoluteValueExpression:
+		Walk(&n.Subject, v)
+	case *AddIntervalExpression:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *AddSubtractDatetimeExpression:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *AddSubtractExpression:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *AddSubtractIntervalExpression:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *AggregateFunction:
+		if n.GeneralSet != nil {
+			Walk(n.GeneralSet, v)
+		}
+		if n.BinarySet != nil {
+			Walk(n.BinarySet, v)
+		}
+		if n.OrderedSet != nil {
+			Walk(n.OrderedSet, v)
+		}
+	case *BetweenPredicate:
+		Walk(&n.Target, v)
+		Walk(&n.Start, v)
+		Walk(&n.End, v)
+	case *BlobFactor:
+		Walk(&n.Primary, v)
+	case *BlobPositionExpression:
+		Walk(&n.Subject, v)
+		Walk(&n.In, v)
+	case *BlobPrimary:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Function != nil {
+			Walk(n.Function, v)
+		}
+	case *BlobValueExpression:
+		if n.Factor != nil {
+			Walk(n.Factor, v)
+		}
+	case *BooleanFactor:
+		Walk(&n.Test, v)
+	case *BooleanPredicand:
+		if n.Parenthesized != nil {
+			Walk(n.Parenthesized, v)
+		}
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+	case *BooleanPrimary:
+		if n.Predicate != nil {
+			Walk(n.Predicate, v)
+		}
+		if n.Predicand != nil {
+			Walk(n.Predicand, v)
+		}
+	case *BooleanTerm:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.AndLeft != nil {
+			Walk(n.AndLeft, v)
+		}
+		if n.AndRight != nil {
+			Walk(n.AndRight, v)
+		}
+	case *BooleanTest:
+		Walk(&n.Primary, v)
+	case *BooleanValueExpression:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.OrLeft != nil {
+			Walk(n.OrLeft, v)
+		}
+		if n.OrRight != nil {
+			Walk(n.OrRight, v)
+		}
+	case *CeilingFunction:
+		Walk(&n.Subject, v)
+	case *CharacterFactor:
+		Walk(&n.Primary, v)
+	case *CharacterLengthExpression:
+		Walk(&n.Subject, v)
+	case *CharacterOverlayFunction:
+		Walk(&n.Subject, v)
+		Walk(&n.Placing, v)
+		Walk(&n.From, v)
+		if n.For != nil {
+			Walk(n.For, v)
+		}
+	case *CharacterPrimary:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Function != nil {
+			Walk(n.Function, v)
+		}
+	case *CharacterSubstringFunction:
+		Walk(&n.Subject, v)
+		Walk(&n.From, v)
+		if n.For != nil {
+			Walk(n.For, v)
+		}
+	case *CharacterTransliterationFunction:
+		Walk(&n.Subject, v)
+		Walk(&n.Using, v)
+	case *CharacterValueExpression:
+		if n.Concatenation != nil {
+			Walk(n.Concatenation, v)
+		}
+		if n.Factor != nil {
+			Walk(n.Factor, v)
+		}
+	case *CharacterValueFunction:
+		if n.Substring != nil {
+			Walk(n.Substring, v)
+		}
+		if n.RegexSubstring != nil {
+			Walk(n.RegexSubstring, v)
+		}
+		if n.Fold != nil {
+			Walk(n.Fold, v)
+		}
+		if n.Transcoding != nil {
+			Walk(n.Transcoding, v)
+		}
+		if n.Transliteration != nil {
+			Walk(n.Transliteration, v)
+		}
+		if n.Trim != nil {
+			Walk(n.Trim, v)
+		}
+		if n.Overlay != nil {
+			Walk(n.Overlay, v)
+		}
+		if n.Normalize != nil {
+			Walk(n.Normalize, v)
+		}
+		if n.SpecificType != nil {
+			Walk(n.SpecificType, v)
+		}
+	case *ColumnReference:
+		if n.BasicIdentifierChain != nil {
+			Walk(n.BasicIdentifierChain, v)
+		}
+	case *CommonValueExpression:
+		if n.Numeric != nil {
+			Walk(n.Numeric, v)
+		}
+		if n.String != nil {
+			Walk(n.String, v)
+		}
+		if n.Datetime != nil {
+			Walk(n.Datetime, v)
+		}
+		if n.Interval != nil {
+			Walk(n.Interval, v)
+		}
+	case *ComparisonPredicate:
+		Walk(&n.A, v)
+		Walk(&n.B, v)
+	case *Concatenation:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *CrossJoin:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *CursorSpecification:
+		Walk(&n.Query, v)
+		if n.OrderBy != nil {
+			Walk(n.OrderBy, v)
+		}
+		if n.Limit != nil {
+			Walk(n.Limit, v)
+		}
+	case *DatetimeFactor:
+		Walk(&n.Primary, v)
+		if n.Timezone != nil {
+			Walk(n.Timezone, v)
+		}
+	case *DatetimePrimary:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Function != nil {
+			Walk(n.Function, v)
+		}
+	case *DatetimeTerm:
+		Walk(&n.Factor, v)
+	case *DatetimeValueExpression:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.AddInterval != nil {
+			Walk(n.AddInterval, v)
+		}
+		if n.AddSubtract != nil {
+			Walk(n.AddSubtract, v)
+		}
+	case *DatetimeValueFunction:
+		if n.CurrentTime != nil {
+			Walk(n.CurrentTime, v)
+		}
+		if n.LocalTime != nil {
+			Walk(n.LocalTime, v)
+		}
+		if n.CurrentTimestamp != nil {
+			Walk(n.CurrentTimestamp, v)
+		}
+		if n.LocalTimestamp != nil {
+			Walk(n.LocalTimestamp, v)
+		}
+	case *DeleteStatementSearched:
+		for x := range n.Using {
+			Walk(&n.Using[x], v)
+		}
+		if n.Where != nil {
+			Walk(n.Where, v)
+		}
+		if n.OrderBy != nil {
+			Walk(n.OrderBy, v)
+		}
+		if n.Limit != nil {
+			Walk(n.Limit, v)
+		}
+		if n.Returning != nil {
+			Walk(n.Returning, v)
+		}
+	case *DerivedColumn:
+		Walk(&n.Value, v)
+	case *DerivedTable:
+		Walk(&n.Subquery, v)
+	case *EndField:
+		if n.Second != nil {
+			Walk(n.Second, v)
+		}
+	case *ExponentialFunction:
+		Walk(&n.Subject, v)
+	case *ExtractExpression:
+		Walk(&n.What, v)
+		Walk(&n.From, v)
+	case *ExtractField:
+		if n.Datetime != nil {
+			Walk(n.Datetime, v)
+		}
+	case *ExtractSource:
+		if n.Datetime != nil {
+			Walk(n.Datetime, v)
+		}
+		if n.Interval != nil {
+			Walk(n.Interval, v)
+		}
+	case *Factor:
+		Walk(&n.Primary, v)
+	case *FloorFunction:
+		Walk(&n.Subject, v)
+	case *FoldFunction:
+		Walk(&n.Subject, v)
+	case *FromClause:
+		for x := range n.TableReferences {
+			Walk(&n.TableReferences[x], v)
+		}
+	case *GeneralLiteral:
+		walkAny(n.Value, v)
+	case *GeneralSetFunction:
+		Walk(&n.Value, v)
+	case *GeneralValueSpecification:
+		if n.HostParameter != nil {
+			Walk(n.HostParameter, v)
+		}
+	case *GroupByClause:
+		for x := range n.GroupingElements {
+			Walk(&n.GroupingElements[x], v)
+		}
+	case *GroupingColumnReference:
+		if n.ColumnReference != nil {
+			Walk(n.ColumnReference, v)
+		}
+	case *GroupingElement:
+		if n.OrdinaryGroupingSet != nil {
+			Walk(n.OrdinaryGroupingSet, v)
+		}
+	case *HavingClause:
+		Walk(&n.Search, v)
+	case *InPredicate:
+		Walk(&n.Target, v)
+		for x := range n.Values {
+			Walk(&n.Values[x], v)
+		}
+	case *InsertStatement:
+		for _, c := range n.Values {
+			walkAny(c, v)
+		}
+		if n.Returning != nil {
+			Walk(n.Returning, v)
+		}
+	case *IntersectQuery:
+		Walk(&n.Term, v)
+		Walk(&n.Primary, v)
+	case *IntervalFactor:
+		Walk(&n.Primary, v)
+	case *IntervalPrimary:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Qualifier != nil {
+			Walk(n.Qualifier, v)
+		}
+		if n.Function != nil {
+			Walk(n.Function, v)
+		}
+	case *IntervalQualifier:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.StartEnd != nil {
+			Walk(n.StartEnd, v)
+		}
+	case *IntervalTerm:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.MultiplyDivide != nil {
+			Walk(n.MultiplyDivide, v)
+		}
+		if n.MultiplyNumeric != nil {
+			Walk(n.MultiplyNumeric, v)
+		}
+	case *IntervalValueExpression:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.AddSubtract != nil {
+			Walk(n.AddSubtract, v)
+		}
+		if n.SubtractDatetime != nil {
+			Walk(n.SubtractDatetime, v)
+		}
+	case *IntervalValueFunction:
+		if n.Abs != nil {
+			Walk(n.Abs, v)
+		}
+	case *JoinedTable:
+		if n.Cross != nil {
+			Walk(n.Cross, v)
+		}
+		if n.Qualified != nil {
+			Walk(n.Qualified, v)
+		}
+		if n.Natural != nil {
+			Walk(n.Natural, v)
+		}
+		if n.Union != nil {
+			Walk(n.Union, v)
+		}
+	case *LengthExpression:
+		if n.Character != nil {
+			Walk(n.Character, v)
+		}
+		if n.Octet != nil {
+			Walk(n.Octet, v)
+		}
+	case *Literal:
+		if n.SignedNumeric != nil {
+			Walk(n.SignedNumeric, v)
+		}
+		if n.General != nil {
+			Walk(n.General, v)
+		}
+	case *ModulusExpression:
+		Walk(&n.Dividend, v)
+		Walk(&n.Divisor, v)
+	case *MultiplyDivideExpression:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *MultiplyDivideIntervalTerm:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *MultiplyNumericIntervalFactor:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *NaturalJoin:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *NaturalLogarithm:
+		Walk(&n.Subject, v)
+	case *NonJoinQueryExpression:
+		if n.NonJoin != nil {
+			Walk(n.NonJoin, v)
+		}
+	case *NonJoinQueryPrimary:
+		if n.Simple != nil {
+			Walk(n.Simple, v)
+		}
+		if n.Parenthesized != nil {
+			Walk(n.Parenthesized, v)
+		}
+	case *NonJoinQueryTerm:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Intersect != nil {
+			Walk(n.Intersect, v)
+		}
+	case *NonParenthesizedValueExpressionPrimary:
+		if n.UnsignedValue != nil {
+			Walk(n.UnsignedValue, v)
+		}
+		if n.ColumnReference != nil {
+			Walk(n.ColumnReference, v)
+		}
+		if n.SetFunction != nil {
+			Walk(n.SetFunction, v)
+		}
+		if n.ScalarSubquery != nil {
+			Walk(n.ScalarSubquery, v)
+		}
+	case *NormalizeFunction:
+		Walk(&n.Subject, v)
+	case *NullPredicate:
+		Walk(&n.Target, v)
+	case *NumericPrimary:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Function != nil {
+			Walk(n.Function, v)
+		}
+	case *NumericValueExpression:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.AddSubtract != nil {
+			Walk(n.AddSubtract, v)
+		}
+	case *NumericValueFunction:
+		if n.Position != nil {
+			Walk(n.Position, v)
+		}
+		if n.Extract != nil {
+			Walk(n.Extract, v)
+		}
+		if n.Length != nil {
+			Walk(n.Length, v)
+		}
+		if n.Cardinality != nil {
+			Walk(n.Cardinality, v)
+		}
+		if n.AbsoluteValue != nil {
+			Walk(n.AbsoluteValue, v)
+		}
+		if n.Modulus != nil {
+			Walk(n.Modulus, v)
+		}
+		if n.Natural != nil {
+			Walk(n.Natural, v)
+		}
+		if n.Exponential != nil {
+			Walk(n.Exponential, v)
+		}
+		if n.Power != nil {
+			Walk(n.Power, v)
+		}
+		if n.SquareRoot != nil {
+			Walk(n.SquareRoot, v)
+		}
+		if n.Floor != nil {
+			Walk(n.Floor, v)
+		}
+		if n.Ceiling != nil {
+			Walk(n.Ceiling, v)
+		}
+		if n.WidthBucket != nil {
+			Walk(n.WidthBucket, v)
+		}
+	case *OctetLengthExpression:
+		Walk(&n.Subject, v)
+	case *OrderByClause:
+		for x := range n.SortSpecifications {
+			Walk(&n.SortSpecifications[x], v)
+		}
+	case *OrdinaryGroupingSet:
+		if n.GroupingColumnReference != nil {
+			Walk(n.GroupingColumnReference, v)
+		}
+	case *PositionExpression:
+		if n.String != nil {
+			Walk(n.String, v)
+		}
+		if n.Blob != nil {
+			Walk(n.Blob, v)
+		}
+	case *PowerFunction:
+		Walk(&n.Base, v)
+		Walk(&n.Exponent, v)
+	case *Predicate:
+		if n.Comparison != nil {
+			Walk(n.Comparison, v)
+		}
+		if n.Between != nil {
+			Walk(n.Between, v)
+		}
+		if n.In != nil {
+			Walk(n.In, v)
+		}
+		if n.Null != nil {
+			Walk(n.Null, v)
+		}
+	case *QualifiedJoin:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+		Walk(&n.On, v)
+	case *QueryExpression:
+		Walk(&n.Body, v)
+	case *QueryExpressionBody:
+		if n.NonJoin != nil {
+			Walk(n.NonJoin, v)
+		}
+		if n.Joined != nil {
+			Walk(n.Joined, v)
+		}
+	case *QueryPrimary:
+		if n.NonJoinQueryPrimary != nil {
+			Walk(n.NonJoinQueryPrimary, v)
+		}
+		if n.JoinedTable != nil {
+			Walk(n.JoinedTable, v)
+		}
+	case *QuerySpecification:
+		Walk(&n.SelectList, v)
+		Walk(&n.TableExpression, v)
+	case *QueryTerm:
+		if n.NonJoinQueryTerm != nil {
+			Walk(n.NonJoinQueryTerm, v)
+		}
+		if n.JoinedTable != nil {
+			Walk(n.JoinedTable, v)
+		}
+	case *RegexSubstringFunction:
+		Walk(&n.Subject, v)
+		Walk(&n.Similar, v)
+		Walk(&n.Escape, v)
+	case *RowValueExpression:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+	case *RowValuePredicand:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Common != nil {
+			Walk(n.Common, v)
+		}
+		if n.Boolean != nil {
+			Walk(n.Boolean, v)
+		}
+	case *SchemaQualifiedName:
+		Walk(&n.Identifiers, v)
+	case *SelectList:
+		for x := range n.Sublists {
+			Walk(&n.Sublists[x], v)
+		}
+	case *SelectSublist:
+		if n.DerivedColumn != nil {
+			Walk(n.DerivedColumn, v)
+		}
+	case *SetFunctionSpecification:
+		if n.Aggregate != nil {
+			Walk(n.Aggregate, v)
+		}
+	case *SignedNumericLiteral:
+		walkAny(n.Value, v)
+	case *SimpleTable:
+		if n.QuerySpecification != nil {
+			Walk(n.QuerySpecification, v)
+		}
+	case *SortSpecification:
+		Walk(&n.Key, v)
+	case *SquareRoot:
+		Walk(&n.Subject, v)
+	case *StartEndDatetimeField:
+		Walk(&n.Start, v)
+		Walk(&n.End, v)
+	case *StringPositionExpression:
+		Walk(&n.Subject, v)
+		Walk(&n.In, v)
+	case *StringValueExpression:
+		if n.Character != nil {
+			Walk(n.Character, v)
+		}
+		if n.Blob != nil {
+			Walk(n.Blob, v)
+		}
+	case *StringValueFunction:
+		if n.Character != nil {
+			Walk(n.Character, v)
+		}
+		if n.Blob != nil {
+			Walk(n.Blob, v)
+		}
+	case *Subquery:
+		Walk(&n.QueryExpression, v)
+	case *SubtractDatetimeExpression:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *TableExpression:
+		Walk(&n.From, v)
+		if n.Where != nil {
+			Walk(n.Where, v)
+		}
+		if n.GroupBy != nil {
+			Walk(n.GroupBy, v)
+		}
+		if n.Having != nil {
+			Walk(n.Having, v)
+		}
+	case *TablePrimary:
+		if n.DerivedTable != nil {
+			Walk(n.DerivedTable, v)
+		}
+		if n.Correlation != nil {
+			Walk(n.Correlation, v)
+		}
+	case *TableReference:
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+		if n.Joined != nil {
+			Walk(n.Joined, v)
+		}
+	case *Term:
+		if n.Unary != nil {
+			Walk(n.Unary, v)
+		}
+		if n.MultiplyDivide != nil {
+			Walk(n.MultiplyDivide, v)
+		}
+	case *TimezoneSpecifier:
+		if n.Timezone != nil {
+			Walk(n.Timezone, v)
+		}
+	case *TranscodingFunction:
+		Walk(&n.Subject, v)
+		Walk(&n.Using, v)
+	case *TrimFunction:
+		if n.Character != nil {
+			Walk(n.Character, v)
+		}
+		Walk(&n.Subject, v)
+	case *UnionJoin:
+		Walk(&n.Left, v)
+		Walk(&n.Right, v)
+	case *UnsignedLiteral:
+		if n.UnsignedNumeric != nil {
+			Walk(n.UnsignedNumeric, v)
+		}
+		if n.General != nil {
+			Walk(n.General, v)
+		}
+	case *UnsignedNumericLiteral:
+		walkAny(n.Value, v)
+	case *UnsignedValueSpecification:
+		if n.UnsignedLiteral != nil {
+			Walk(n.UnsignedLiteral, v)
+		}
+		if n.GeneralValue != nil {
+			Walk(n.GeneralValue, v)
+		}
+	case *UpdateStatementSearched:
+		for _, c := range n.Values {
+			walkAny(c, v)
+		}
+		for x := range n.From {
+			Walk(&n.From[x], v)
+		}
+		if n.Where != nil {
+			Walk(n.Where, v)
+		}
+		if n.OrderBy != nil {
+			Walk(n.OrderBy, v)
+		}
+		if n.Limit != nil {
+			Walk(n.Limit, v)
+		}
+		if n.Returning != nil {
+			Walk(n.Returning, v)
+		}
+	case *ValueExpression:
+		if n.Common != nil {
+			Walk(n.Common, v)
+		}
+		if n.Boolean != nil {
+			Walk(n.Boolean, v)
+		}
+		if n.Row != nil {
+			Walk(n.Row, v)
+		}
+	case *ValueExpressionPrimary:
+		if n.Parenthesized != nil {
+			Walk(n.Parenthesized, v)
+		}
+		if n.Primary != nil {
+			Walk(n.Primary, v)
+		}
+	case *ValueSpecification:
+		if n.Literal != nil {
+			Walk(n.Literal, v)
+		}
+		if n.UnsignedValue != nil {
+			Walk(n.UnsignedValue, v)
+		}
+	case *WhereClause:
+		Walk(&n.Search, v)
+	case *WidthBucketFunction:
+		Walk(&n.Operand, v)
+		Walk(&n.Bound1, v)
+		Walk(&n.Bound2, v)
+		Walk(&n.Count, v)
+	}
+}
+
+// rewriteChildren rewrites each child of the supplied Node with the supplied
+// function, in the order of the fields of the Node's struct
+func rewriteChildren(node Node, fn func(Node) Node) {
+	switch n := node.(type) {
+	case *AbsoluteValueExpression:
+		rewriteValue(&n.Subject, fn)
+	case *AddIntervalExpression:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *AddSubtractDatetimeExpression:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *AddSubtractExpression:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *AddSubtractIntervalExpression:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *AggregateFunction:
+		if n.GeneralSet != nil {
+			n.GeneralSet = rewritePointer(n.GeneralSet, fn)
+		}
+		if n.BinarySet != nil {
+			n.BinarySet = rewritePointer(n.BinarySet, fn)
+		}
+		if n.OrderedSet != nil {
+			n.OrderedSet = rewritePointer(n.OrderedSet, fn)
+		}
+	case *BetweenPredicate:
+		rewriteValue(&n.Target, fn)
+		rewriteValue(&n.Start, fn)
+		rewriteValue(&n.End, fn)
+	case *BlobFactor:
+		rewriteValue(&n.Primary, fn)
+	case *BlobPositionExpression:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.In, fn)
+	case *BlobPrimary:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Function != nil {
+			n.Function = rewritePointer(n.Function, fn)
+		}
+	case *BlobValueExpression:
+		if n.Factor != nil {
+			n.Factor = rewritePointer(n.Factor, fn)
+		}
+	case *BooleanFactor:
+		rewriteValue(&n.Test, fn)
+	case *BooleanPredicand:
+		if n.Parenthesized != nil {
+			n.Parenthesized = rewritePointer(n.Parenthesized, fn)
+		}
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+	case *BooleanPrimary:
+		if n.Predicate != nil {
+			n.Predicate = rewritePointer(n.Predicate, fn)
+		}
+		if n.Predicand != nil {
+			n.Predicand = rewritePointer(n.Predicand, fn)
+		}
+	case *BooleanTerm:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.AndLeft != nil {
+			n.AndLeft = rewritePointer(n.AndLeft, fn)
+		}
+		if n.AndRight != nil {
+			n.AndRight = rewritePointer(n.AndRight, fn)
+		}
+	case *BooleanTest:
+		rewriteValue(&n.Primary, fn)
+	case *BooleanValueExpression:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.OrLeft != nil {
+			n.OrLeft = rewritePointer(n.OrLeft, fn)
+		}
+		if n.OrRight != nil {
+			n.OrRight = rewritePointer(n.OrRight, fn)
+		}
+	case *CeilingFunction:
+		rewriteValue(&n.Subject, fn)
+	case *CharacterFactor:
+		rewriteValue(&n.Primary, fn)
+	case *CharacterLengthExpression:
+		rewriteValue(&n.Subject, fn)
+	case *CharacterOverlayFunction:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.Placing, fn)
+		rewriteValue(&n.From, fn)
+		if n.For != nil {
+			n.For = rewritePointer(n.For, fn)
+		}
+	case *CharacterPrimary:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Function != nil {
+			n.Function = rewritePointer(n.Function, fn)
+		}
+	case *CharacterSubstringFunction:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.From, fn)
+		if n.For != nil {
+			n.For = rewritePointer(n.For, fn)
+		}
+	case *CharacterTransliterationFunction:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.Using, fn)
+	case *CharacterValueExpression:
+		if n.Concatenation != nil {
+			n.Concatenation = rewritePointer(n.Concatenation, fn)
+		}
+		if n.Factor != nil {
+			n.Factor = rewritePointer(n.Factor, fn)
+		}
+	case *CharacterValueFunction:
+		if n.Substring != nil {
+			n.Substring = rewritePointer(n.Substring, fn)
+		}
+		if n.RegexSubstring != nil {
+			n.RegexSubstring = rewritePointer(n.RegexSubstring, fn)
+		}
+		if n.Fold != nil {
+			n.Fold = rewritePointer(n.Fold, fn)
+		}
+		if n.Transcoding != nil {
+			n.Transcoding = rewritePointer(n.Transcoding, fn)
+		}
+		if n.Transliteration != nil {
+			n.Transliteration = rewritePointer(n.Transliteration, fn)
+		}
+		if n.Trim != nil {
+			n.Trim = rewritePointer(n.Trim, fn)
+		}
+		if n.Overlay != nil {
+			n.Overlay = rewritePointer(n.Overlay, fn)
+		}
+		if n.Normalize != nil {
+			n.Normalize = rewritePointer(n.Normalize, fn)
+		}
+		if n.SpecificType != nil {
+			n.SpecificType = rewritePointer(n.SpecificType, fn)
+		}
+	case *ColumnReference:
+		if n.BasicIdentifierChain != nil {
+			n.BasicIdentifierChain = rewritePointer(n.BasicIdentifierChain, fn)
+		}
+	case *CommonValueExpression:
+		if n.Numeric != nil {
+			n.Numeric = rewritePointer(n.Numeric, fn)
+		}
+		if n.String != nil {
+			n.String = rewritePointer(n.String, fn)
+		}
+		if n.Datetime != nil {
+			n.Datetime = rewritePointer(n.Datetime, fn)
+		}
+		if n.Interval != nil {
+			n.Interval = rewritePointer(n.Interval, fn)
+		}
+	case *ComparisonPredicate:
+		rewriteValue(&n.A, fn)
+		rewriteValue(&n.B, fn)
+	case *Concatenation:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *CrossJoin:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *CursorSpecification:
+		rewriteValue(&n.Query, fn)
+		if n.OrderBy != nil {
+			n.OrderBy = rewritePointer(n.OrderBy, fn)
+		}
+		if n.Limit != nil {
+			n.Limit = rewritePointer(n.Limit, fn)
+		}
+	case *DatetimeFactor:
+		rewriteValue(&n.Primary, fn)
+		if n.Timezone != nil {
+			n.Timezone = rewritePointer(n.Timezone, fn)
+		}
+	case *DatetimePrimary:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Function != nil {
+			n.Function = rewritePointer(n.Function, fn)
+		}
+	case *DatetimeTerm:
+		rewriteValue(&n.Factor, fn)
+	case *DatetimeValueExpression:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.AddInterval != nil {
+			n.AddInterval = rewritePointer(n.AddInterval, fn)
+		}
+		if n.AddSubtract != nil {
+			n.AddSubtract = rewritePointer(n.AddSubtract, fn)
+		}
+	case *DatetimeValueFunction:
+		if n.CurrentTime != nil {
+			n.CurrentTime = rewritePointer(n.CurrentTime, fn)
+		}
+		if n.LocalTime != nil {
+			n.LocalTime = rewritePointer(n.LocalTime, fn)
+		}
+		if n.CurrentTimestamp != nil {
+			n.CurrentTimestamp = rewritePointer(n.CurrentTimestamp, fn)
+		}
+		if n.LocalTimestamp != nil {
+			n.LocalTimestamp = rewritePointer(n.LocalTimestamp, fn)
+		}
+	case *DeleteStatementSearched:
+		for x := range n.Using {
+			rewriteValue(&n.Using[x], fn)
+		}
+		if n.Where != nil {
+			n.Where = rewritePointer(n.Where, fn)
+		}
+		if n.OrderBy != nil {
+			n.OrderBy = rewritePointer(n.OrderBy, fn)
+		}
+		if n.Limit != nil {
+			n.Limit = rewritePointer(n.Limit, fn)
+		}
+		if n.Returning != nil {
+			n.Returning = rewritePointer(n.Returning, fn)
+		}
+	case *DerivedColumn:
+		rewriteValue(&n.Value, fn)
+	case *DerivedTable:
+		rewriteValue(&n.Subquery, fn)
+	case *EndField:
+		if n.Second != nil {
+			n.Second = rewritePointer(n.Second, fn)
+		}
+	case *ExponentialFunction:
+		rewriteValue(&n.Subject, fn)
+	case *ExtractExpression:
+		rewriteValue(&n.What, fn)
+		rewriteValue(&n.From, fn)
+	case *ExtractField:
+		if n.Datetime != nil {
+			n.Datetime = rewritePointer(n.Datetime, fn)
+		}
+	case *ExtractSource:
+		if n.Datetime != nil {
+			n.Datetime = rewritePointer(n.Datetime, fn)
+		}
+		if n.Interval != nil {
+			n.Interval = rewritePointer(n.Interval, fn)
+		}
+	case *Factor:
+		rewriteValue(&n.Primary, fn)
+	case *FloorFunction:
+		rewriteValue(&n.Subject, fn)
+	case *FoldFunction:
+		rewriteValue(&n.Subject, fn)
+	case *FromClause:
+		for x := range n.TableReferences {
+			rewriteValue(&n.TableReferences[x], fn)
+		}
+	case *GeneralLiteral:
+		n.Value = rewriteAny(n.Value, fn)
+	case *GeneralSetFunction:
+		rewriteValue(&n.Value, fn)
+	case *GeneralValueSpecification:
+		if n.HostParameter != nil {
+			n.HostParameter = rewritePointer(n.HostParameter, fn)
+		}
+	case *GroupByClause:
+		for x := range n.GroupingElements {
+			rewriteValue(&n.GroupingElements[x], fn)
+		}
+	case *GroupingColumnReference:
+		if n.ColumnReference != nil {
+			n.ColumnReference = rewritePointer(n.ColumnReference, fn)
+		}
+	case *GroupingElement:
+		if n.OrdinaryGroupingSet != nil {
+			n.OrdinaryGroupingSet = rewritePointer(n.OrdinaryGroupingSet, fn)
+		}
+	case *HavingClause:
+		rewriteValue(&n.Search, fn)
+	case *InPredicate:
+		rewriteValue(&n.Target, fn)
+		for x := range n.Values {
+			rewriteValue(&n.Values[x], fn)
+		}
+	case *InsertStatement:
+		for x, c := range n.Values {
+			n.Values[x] = rewriteAny(c, fn)
+		}
+		if n.Returning != nil {
+			n.Returning = rewritePointer(n.Returning, fn)
+		}
+	case *IntersectQuery:
+		rewriteValue(&n.Term, fn)
+		rewriteValue(&n.Primary, fn)
+	case *IntervalFactor:
+		rewriteValue(&n.Primary, fn)
+	case *IntervalPrimary:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Qualifier != nil {
+			n.Qualifier = rewritePointer(n.Qualifier, fn)
+		}
+		if n.Function != nil {
+			n.Function = rewritePointer(n.Function, fn)
+		}
+	case *IntervalQualifier:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.StartEnd != nil {
+			n.StartEnd = rewritePointer(n.StartEnd, fn)
+		}
+	case *IntervalTerm:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.MultiplyDivide != nil {
+			n.MultiplyDivide = rewritePointer(n.MultiplyDivide, fn)
+		}
+		if n.MultiplyNumeric != nil {
+			n.MultiplyNumeric = rewritePointer(n.MultiplyNumeric, fn)
+		}
+	case *IntervalValueExpression:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.AddSubtract != nil {
+			n.AddSubtract = rewritePointer(n.AddSubtract, fn)
+		}
+		if n.SubtractDatetime != nil {
+			n.SubtractDatetime = rewritePointer(n.SubtractDatetime, fn)
+		}
+	case *IntervalValueFunction:
+		if n.Abs != nil {
+			n.Abs = rewritePointer(n.Abs, fn)
+		}
+	case *JoinedTable:
+		if n.Cross != nil {
+			n.Cross = rewritePointer(n.Cross, fn)
+		}
+		if n.Qualified != nil {
+			n.Qualified = rewritePointer(n.Qualified, fn)
+		}
+		if n.Natural != nil {
+			n.Natural = rewritePointer(n.Natural, fn)
+		}
+		if n.Union != nil {
+			n.Union = rewritePointer(n.Union, fn)
+		}
+	case *LengthExpression:
+		if n.Character != nil {
+			n.Character = rewritePointer(n.Character, fn)
+		}
+		if n.Octet != nil {
+			n.Octet = rewritePointer(n.Octet, fn)
+		}
+	case *Literal:
+		if n.SignedNumeric != nil {
+			n.SignedNumeric = rewritePointer(n.SignedNumeric, fn)
+		}
+		if n.General != nil {
+			n.General = rewritePointer(n.General, fn)
+		}
+	case *ModulusExpression:
+		rewriteValue(&n.Dividend, fn)
+		rewriteValue(&n.Divisor, fn)
+	case *MultiplyDivideExpression:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *MultiplyDivideIntervalTerm:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *MultiplyNumericIntervalFactor:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *NaturalJoin:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *NaturalLogarithm:
+		rewriteValue(&n.Subject, fn)
+	case *NonJoinQueryExpression:
+		if n.NonJoin != nil {
+			n.NonJoin = rewritePointer(n.NonJoin, fn)
+		}
+	case *NonJoinQueryPrimary:
+		if n.Simple != nil {
+			n.Simple = rewritePointer(n.Simple, fn)
+		}
+		if n.Parenthesized != nil {
+			n.Parenthesized = rewritePointer(n.Parenthesized, fn)
+		}
+	case *NonJoinQueryTerm:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Intersect != nil {
+			n.Intersect = rewritePointer(n.Intersect, fn)
+		}
+	case *NonParenthesizedValueExpressionPrimary:
+		if n.UnsignedValue != nil {
+			n.UnsignedValue = rewritePointer(n.UnsignedValue, fn)
+		}
+		if n.ColumnReference != nil {
+			n.ColumnReference = rewritePointer(n.ColumnReference, fn)
+		}
+		if n.SetFunction != nil {
+			n.SetFunction = rewritePointer(n.SetFunction, fn)
+		}
+		if n.ScalarSubquery != nil {
+			n.ScalarSubquery = rewritePointer(n.ScalarSubquery, fn)
+		}
+	case *NormalizeFunction:
+		rewriteValue(&n.Subject, fn)
+	case *NullPredicate:
+		rewriteValue(&n.Target, fn)
+	case *NumericPrimary:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Function != nil {
+			n.Function = rewritePointer(n.Function, fn)
+		}
+	case *NumericValueExpression:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.AddSubtract != nil {
+			n.AddSubtract = rewritePointer(n.AddSubtract, fn)
+		}
+	case *NumericValueFunction:
+		if n.Position != nil {
+			n.Position = rewritePointer(n.Position, fn)
+		}
+		if n.Extract != nil {
+			n.Extract = rewritePointer(n.Extract, fn)
+		}
+		if n.Length != nil {
+			n.Length = rewritePointer(n.Length, fn)
+		}
+		if n.Cardinality != nil {
+			n.Cardinality = rewritePointer(n.Cardinality, fn)
+		}
+		if n.AbsoluteValue != nil {
+			n.AbsoluteValue = rewritePointer(n.AbsoluteValue, fn)
+		}
+		if n.Modulus != nil {
+			n.Modulus = rewritePointer(n.Modulus, fn)
+		}
+		if n.Natural != nil {
+			n.Natural = rewritePointer(n.Natural, fn)
+		}
+		if n.Exponential != nil {
+			n.Exponential = rewritePointer(n.Exponential, fn)
+		}
+		if n.Power != nil {
+			n.Power = rewritePointer(n.Power, fn)
+		}
+		if n.SquareRoot != nil {
+			n.SquareRoot = rewritePointer(n.SquareRoot, fn)
+		}
+		if n.Floor != nil {
+			n.Floor = rewritePointer(n.Floor, fn)
+		}
+		if n.Ceiling != nil {
+			n.Ceiling = rewritePointer(n.Ceiling, fn)
+		}
+		if n.WidthBucket != nil {
+			n.WidthBucket = rewritePointer(n.WidthBucket, fn)
+		}
+	case *OctetLengthExpression:
+		rewriteValue(&n.Subject, fn)
+	case *OrderByClause:
+		for x := range n.SortSpecifications {
+			rewriteValue(&n.SortSpecifications[x], fn)
+		}
+	case *OrdinaryGroupingSet:
+		if n.GroupingColumnReference != nil {
+			n.GroupingColumnReference = rewritePointer(n.GroupingColumnReference, fn)
+		}
+	case *PositionExpression:
+		if n.String != nil {
+			n.String = rewritePointer(n.String, fn)
+		}
+		if n.Blob != nil {
+			n.Blob = rewritePointer(n.Blob, fn)
+		}
+	case *PowerFunction:
+		rewriteValue(&n.Base, fn)
+		rewriteValue(&n.Exponent, fn)
+	case *Predicate:
+		if n.Comparison != nil {
+			n.Comparison = rewritePointer(n.Comparison, fn)
+		}
+		if n.Between != nil {
+			n.Between = rewritePointer(n.Between, fn)
+		}
+		if n.In != nil {
+			n.In = rewritePointer(n.In, fn)
+		}
+		if n.Null != nil {
+			n.Null = rewritePointer(n.Null, fn)
+		}
+	case *QualifiedJoin:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+		rewriteValue(&n.On, fn)
+	case *QueryExpression:
+		rewriteValue(&n.Body, fn)
+	case *QueryExpressionBody:
+		if n.NonJoin != nil {
+			n.NonJoin = rewritePointer(n.NonJoin, fn)
+		}
+		if n.Joined != nil {
+			n.Joined = rewritePointer(n.Joined, fn)
+		}
+	case *QueryPrimary:
+		if n.NonJoinQueryPrimary != nil {
+			n.NonJoinQueryPrimary = rewritePointer(n.NonJoinQueryPrimary, fn)
+		}
+		if n.JoinedTable != nil {
+			n.JoinedTable = rewritePointer(n.JoinedTable, fn)
+		}
+	case *QuerySpecification:
+		rewriteValue(&n.SelectList, fn)
+		rewriteValue(&n.TableExpression, fn)
+	case *QueryTerm:
+		if n.NonJoinQueryTerm != nil {
+			n.NonJoinQueryTerm = rewritePointer(n.NonJoinQueryTerm, fn)
+		}
+		if n.JoinedTable != nil {
+			n.JoinedTable = rewritePointer(n.JoinedTable, fn)
+		}
+	case *RegexSubstringFunction:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.Similar, fn)
+		rewriteValue(&n.Escape, fn)
+	case *RowValueExpression:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+	case *RowValuePredicand:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Common != nil {
+			n.Common = rewritePointer(n.Common, fn)
+		}
+		if n.Boolean != nil {
+			n.Boolean = rewritePointer(n.Boolean, fn)
+		}
+	case *SchemaQualifiedName:
+		rewriteValue(&n.Identifiers, fn)
+	case *SelectList:
+		for x := range n.Sublists {
+			rewriteValue(&n.Sublists[x], fn)
+		}
+	case *SelectSublist:
+		if n.DerivedColumn != nil {
+			n.DerivedColumn = rewritePointer(n.DerivedColumn, fn)
+		}
+	case *SetFunctionSpecification:
+		if n.Aggregate != nil {
+			n.Aggregate = rewritePointer(n.Aggregate, fn)
+		}
+	case *SignedNumericLiteral:
+		n.Value = rewriteAny(n.Value, fn)
+	case *SimpleTable:
+		if n.QuerySpecification != nil {
+			n.QuerySpecification = rewritePointer(n.QuerySpecification, fn)
+		}
+	case *SortSpecification:
+		rewriteValue(&n.Key, fn)
+	case *SquareRoot:
+		rewriteValue(&n.Subject, fn)
+	case *StartEndDatetimeField:
+		rewriteValue(&n.Start, fn)
+		rewriteValue(&n.End, fn)
+	case *StringPositionExpression:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.In, fn)
+	case *StringValueExpression:
+		if n.Character != nil {
+			n.Character = rewritePointer(n.Character, fn)
+		}
+		if n.Blob != nil {
+			n.Blob = rewritePointer(n.Blob, fn)
+		}
+	case *StringValueFunction:
+		if n.Character != nil {
+			n.Character = rewritePointer(n.Character, fn)
+		}
+		if n.Blob != nil {
+			n.Blob = rewritePointer(n.Blob, fn)
+		}
+	case *Subquery:
+		rewriteValue(&n.QueryExpression, fn)
+	case *SubtractDatetimeExpression:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *TableExpression:
+		rewriteValue(&n.From, fn)
+		if n.Where != nil {
+			n.Where = rewritePointer(n.Where, fn)
+		}
+		if n.GroupBy != nil {
+			n.GroupBy = rewritePointer(n.GroupBy, fn)
+		}
+		if n.Having != nil {
+			n.Having = rewritePointer(n.Having, fn)
+		}
+	case *TablePrimary:
+		if n.DerivedTable != nil {
+			n.DerivedTable = rewritePointer(n.DerivedTable, fn)
+		}
+		if n.Correlation != nil {
+			n.Correlation = rewritePointer(n.Correlation, fn)
+		}
+	case *TableReference:
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+		if n.Joined != nil {
+			n.Joined = rewritePointer(n.Joined, fn)
+		}
+	case *Term:
+		if n.Unary != nil {
+			n.Unary = rewritePointer(n.Unary, fn)
+		}
+		if n.MultiplyDivide != nil {
+			n.MultiplyDivide = rewritePointer(n.MultiplyDivide, fn)
+		}
+	case *TimezoneSpecifier:
+		if n.Timezone != nil {
+			n.Timezone = rewritePointer(n.Timezone, fn)
+		}
+	case *TranscodingFunction:
+		rewriteValue(&n.Subject, fn)
+		rewriteValue(&n.Using, fn)
+	case *TrimFunction:
+		if n.Character != nil {
+			n.Character = rewritePointer(n.Character, fn)
+		}
+		rewriteValue(&n.Subject, fn)
+	case *UnionJoin:
+		rewriteValue(&n.Left, fn)
+		rewriteValue(&n.Right, fn)
+	case *UnsignedLiteral:
+		if n.UnsignedNumeric != nil {
+			n.UnsignedNumeric = rewritePointer(n.UnsignedNumeric, fn)
+		}
+		if n.General != nil {
+			n.General = rewritePointer(n.General, fn)
+		}
+	case *UnsignedNumericLiteral:
+		n.Value = rewriteAny(n.Value, fn)
+	case *UnsignedValueSpecification:
+		if n.UnsignedLiteral != nil {
+			n.UnsignedLiteral = rewritePointer(n.UnsignedLiteral, fn)
+		}
+		if n.GeneralValue != nil {
+			n.GeneralValue = rewritePointer(n.GeneralValue, fn)
+		}
+	case *UpdateStatementSearched:
+		for x, c := range n.Values {
+			n.Values[x] = rewriteAny(c, fn)
+		}
+		for x := range n.From {
+			rewriteValue(&n.From[x], fn)
+		}
+		if n.Where != nil {
+			n.Where = rewritePointer(n.Where, fn)
+		}
+		if n.OrderBy != nil {
+			n.OrderBy = rewritePointer(n.OrderBy, fn)
+		}
+		if n.Limit != nil {
+			n.Limit = rewritePointer(n.Limit, fn)
+		}
+		if n.Returning != nil {
+			n.Returning = rewritePointer(n.Returning, fn)
+		}
+	case *ValueExpression:
+		if n.Common != nil {
+			n.Common = rewritePointer(n.Common, fn)
+		}
+		if n.Boolean != nil {
+			n.Boolean = rewritePointer(n.Boolean, fn)
+		}
+		if n.Row != nil {
+			n.Row = rewritePointer(n.Row, fn)
+		}
+	case *ValueExpressionPrimary:
+		if n.Parenthesized != nil {
+			n.Parenthesized = rewritePointer(n.Parenthesized, fn)
+		}
+		if n.Primary != nil {
+			n.Primary = rewritePointer(n.Primary, fn)
+		}
+	case *ValueSpecification:
+		if n.Literal != nil {
+			n.Literal = rewritePointer(n.Literal, fn)
+		}
+		if n.UnsignedValue != nil {
+			n.UnsignedValue = rewritePointer(n.UnsignedValue, fn)
+		}
+	case *WhereClause:
+		rewriteValue(&n.Search, fn)
+	case *WidthBucketFunction:
+		rewriteValue(&n.Operand, fn)
+		rewriteValue(&n.Bound1, fn)
+		rewriteValue(&n.Bound2, fn)
+		rewriteValue(&n.Count, fn)
+	}
+}
+
+// cloneNode returns a deep copy of the supplied Node
+func cloneNode(node Node) Node {
+	switch n := node.(type) {
+	case *AbsoluteValueExpression:
+		return n.Clone()
+	case *AddIntervalExpression:
+		return n.Clone()
+	case *AddSubtractDatetimeExpression:
+		return n.Clone()
+	case *AddSubtractExpression:
+		return n.Clone()
+	case *AddSubtractIntervalExpression:
+		return n.Clone()
+	case *AggregateFunction:
+		return n.Clone()
+	case *BetweenPredicate:
+		return n.Clone()
+	case *BinarySetFunction:
+		return n.Clone()
+	case *BlobFactor:
+		return n.Clone()
+	case *BlobPositionExpression:
+		return n.Clone()
+	case *BlobPrimary:
+		return n.Clone()
+	case *BlobValueExpression:
+		return n.Clone()
+	case *BlobValueFunction:
+		return n.Clone()
+	case *BooleanFactor:
+		return n.Clone()
+	case *BooleanPredicand:
+		return n.Clone()
+	case *BooleanPrimary:
+		return n.Clone()
+	case *BooleanTerm:
+		return n.Clone()
+	case *BooleanTest:
+		return n.Clone()
+	case *BooleanValueExpression:
+		return n.Clone()
+	case *CardinalityExpression:
+		return n.Clone()
+	case *CeilingFunction:
+		return n.Clone()
+	case *CharacterFactor:
+		return n.Clone()
+	case *CharacterLengthExpression:
+		return n.Clone()
+	case *CharacterOverlayFunction:
+		return n.Clone()
+	case *CharacterPrimary:
+		return n.Clone()
+	case *CharacterSubstringFunction:
+		return n.Clone()
+	case *CharacterTransliterationFunction:
+		return n.Clone()
+	case *CharacterValueExpression:
+		return n.Clone()
+	case *CharacterValueFunction:
+		return n.Clone()
+	case *ColumnReference:
+		return n.Clone()
+	case *CommonValueExpression:
+		return n.Clone()
+	case *ComparisonPredicate:
+		return n.Clone()
+	case *Concatenation:
+		return n.Clone()
+	case *Correlation:
+		return n.Clone()
+	case *CrossJoin:
+		return n.Clone()
+	case *CurrentTimeFunction:
+		return n.Clone()
+	case *CurrentTimestampFunction:
+		return n.Clone()
+	case *CursorSpecification:
+		return n.Clone()
+	case *DatetimeFactor:
+		return n.Clone()
+	case *DatetimePrimary:
+		return n.Clone()
+	case *DatetimeTerm:
+		return n.Clone()
+	case *DatetimeValueExpression:
+		return n.Clone()
+	case *DatetimeValueFunction:
+		return n.Clone()
+	case *DeleteStatementSearched:
+		return n.Clone()
+	case *DerivedColumn:
+		return n.Clone()
+	case *DerivedTable:
+		return n.Clone()
+	case *EndField:
+		return n.Clone()
+	case *ExponentialFunction:
+		return n.Clone()
+	case *ExtractExpression:
+		return n.Clone()
+	case *ExtractField:
+		return n.Clone()
+	case *ExtractSource:
+		return n.Clone()
+	case *Factor:
+		return n.Clone()
+	case *FloorFunction:
+		return n.Clone()
+	case *FoldFunction:
+		return n.Clone()
+	case *FromClause:
+		return n.Clone()
+	case *GeneralLiteral:
+		return n.Clone()
+	case *GeneralSetFunction:
+		return n.Clone()
+	case *GeneralValueSpecification:
+		return n.Clone()
+	case *GroupByClause:
+		return n.Clone()
+	case *GroupingColumnReference:
+		return n.Clone()
+	case *GroupingElement:
+		return n.Clone()
+	case *HavingClause:
+		return n.Clone()
+	case *HostParameterSpecification:
+		return n.Clone()
+	case *IdentifierChain:
+		return n.Clone()
+	case *InPredicate:
+		return n.Clone()
+	case *InsertStatement:
+		return n.Clone()
+	case *IntersectQuery:
+		return n.Clone()
+	case *IntervalFactor:
+		return n.Clone()
+	case *IntervalPrimary:
+		return n.Clone()
+	case *IntervalQualifier:
+		return n.Clone()
+	case *IntervalTerm:
+		return n.Clone()
+	case *IntervalValueExpression:
+		return n.Clone()
+	case *IntervalValueFunction:
+		return n.Clone()
+	case *JoinedTable:
+		return n.Clone()
+	case *LengthExpression:
+		return n.Clone()
+	case *LimitClause:
+		return n.Clone()
+	case *Literal:
+		return n.Clone()
+	case *LocalTimeFunction:
+		return n.Clone()
+	case *LocalTimestampFunction:
+		return n.Clone()
+	case *ModulusExpression:
+		return n.Clone()
+	case *MultiplyDivideExpression:
+		return n.Clone()
+	case *MultiplyDivideIntervalTerm:
+		return n.Clone()
+	case *MultiplyNumericIntervalFactor:
+		return n.Clone()
+	case *NaturalJoin:
+		return n.Clone()
+	case *NaturalLogarithm:
+		return n.Clone()
+	case *NonJoinQueryExpression:
+		return n.Clone()
+	case *NonJoinQueryPrimary:
+		return n.Clone()
+	case *NonJoinQueryTerm:
+		return n.Clone()
+	case *NonParenthesizedValueExpressionPrimary:
+		return n.Clone()
+	case *NormalizeFunction:
+		return n.Clone()
+	case *NullPredicate:
+		return n.Clone()
+	case *NumericPrimary:
+		return n.Clone()
+	case *NumericValueExpression:
+		return n.Clone()
+	case *NumericValueFunction:
+		return n.Clone()
+	case *OctetLengthExpression:
+		return n.Clone()
+	case *OrderByClause:
+		return n.Clone()
+	case *OrderedSetFunction:
+		return n.Clone()
+	case *OrdinaryGroupingSet:
+		return n.Clone()
+	case *PositionExpression:
+		return n.Clone()
+	case *PowerFunction:
+		return n.Clone()
+	case *Predicate:
+		return n.Clone()
+	case *PrimaryDatetimeField:
+		return n.Clone()
+	case *QualifiedJoin:
+		return n.Clone()
+	case *QueryExpression:
+		return n.Clone()
+	case *QueryExpressionBody:
+		return n.Clone()
+	case *QueryPrimary:
+		return n.Clone()
+	case *QuerySpecification:
+		return n.Clone()
+	case *QueryTerm:
+		return n.Clone()
+	case *RegexSubstringFunction:
+		return n.Clone()
+	case *ReturningClause:
+		return n.Clone()
+	case *RowValueExpression:
+		return n.Clone()
+	case *RowValuePredicand:
+		return n.Clone()
+	case *SchemaQualifiedName:
+		return n.Clone()
+	case *SecondPrimaryDatetimeField:
+		return n.Clone()
+	case *SelectList:
+		return n.Clone()
+	case *SelectSublist:
+		return n.Clone()
+	case *SetFunctionSpecification:
+		return n.Clone()
+	case *SignedNumericLiteral:
+		return n.Clone()
+	case *SimpleTable:
+		return n.Clone()
+	case *SingleDatetimeField:
+		return n.Clone()
+	case *SortSpecification:
+		return n.Clone()
+	case *SpecificTypeFunction:
+		return n.Clone()
+	case *SquareRoot:
+		return n.Clone()
+	case *StartEndDatetimeField:
+		return n.Clone()
+	case *StartField:
+		return n.Clone()
+	case *StringPositionExpression:
+		return n.Clone()
+	case *StringValueExpression:
+		return n.Clone()
+	case *StringValueFunction:
+		return n.Clone()
+	case *Subquery:
+		return n.Clone()
+	case *SubtractDatetimeExpression:
+		return n.Clone()
+	case *TableExpression:
+		return n.Clone()
+	case *TablePrimary:
+		return n.Clone()
+	case *TableReference:
+		return n.Clone()
+	case *Term:
+		return n.Clone()
+	case *TimezoneSpecifier:
+		return n.Clone()
+	case *TranscodingFunction:
+		return n.Clone()
+	case *TrimFunction:
+		return n.Clone()
+	case *TruncateTableStatement:
+		return n.Clone()
+	case *UnionJoin:
+		return n.Clone()
+	case *UnsignedLiteral:
+		return n.Clone()
+	case *UnsignedNumericLiteral:
+		return n.Clone()
+	case *UnsignedValueSpecification:
+		return n.Clone()
+	case *UpdateStatementSearched:
+		return n.Clone()
+	case *ValueExpression:
+		return n.Clone()
+	case *ValueExpressionPrimary:
+		return n.Clone()
+	case *ValueSpecification:
+		return n.Clone()
+	case *WhereClause:
+		return n.Clone()
+	case *WidthBucketFunction:
+		return n.Clone()
+	}
+	return node
+}
+
+// Clone returns a deep copy of the AbsoluteValueExpression
+func (n *AbsoluteValueExpression) Clone() *AbsoluteValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &AbsoluteValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *AbsoluteValueExpression) cloneInto(c *AbsoluteValueExpression) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the AddIntervalExpression
+func (n *AddIntervalExpression) Clone() *AddIntervalExpression {
+	if n == nil {
+		return nil
+	}
+	c := &AddIntervalExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *AddIntervalExpression) cloneInto(c *AddIntervalExpression) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the AddSubtractDatetimeExpression
+func (n *AddSubtractDatetimeExpression) Clone() *AddSubtractDatetimeExpression {
+	if n == nil {
+		return nil
+	}
+	c := &AddSubtractDatetimeExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *AddSubtractDatetimeExpression) cloneInto(c *AddSubtractDatetimeExpression) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the AddSubtractExpression
+func (n *AddSubtractExpression) Clone() *AddSubtractExpression {
+	if n == nil {
+		return nil
+	}
+	c := &AddSubtractExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *AddSubtractExpression) cloneInto(c *AddSubtractExpression) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the AddSubtractIntervalExpression
+func (n *AddSubtractIntervalExpression) Clone() *AddSubtractIntervalExpression {
+	if n == nil {
+		return nil
+	}
+	c := &AddSubtractIntervalExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *AddSubtractIntervalExpression) cloneInto(c *AddSubtractIntervalExpression) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the AggregateFunction
+func (n *AggregateFunction) Clone() *AggregateFunction {
+	if n == nil {
+		return nil
+	}
+	c := &AggregateFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *AggregateFunction) cloneInto(c *AggregateFunction) {
+	*c = *n
+	c.CountStar = clonePointer(n.CountStar)
+	c.GeneralSet = n.GeneralSet.Clone()
+	c.BinarySet = n.BinarySet.Clone()
+	c.OrderedSet = n.OrderedSet.Clone()
+}
+
+// Clone returns a deep copy of the BetweenPredicate
+func (n *BetweenPredicate) Clone() *BetweenPredicate {
+	if n == nil {
+		return nil
+	}
+	c := &BetweenPredicate{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BetweenPredicate) cloneInto(c *BetweenPredicate) {
+	*c = *n
+	n.Target.cloneInto(&c.Target)
+	n.Start.cloneInto(&c.Start)
+	n.End.cloneInto(&c.End)
+}
+
+// Clone returns a deep copy of the BinarySetFunction
+func (n *BinarySetFunction) Clone() *BinarySetFunction {
+	if n == nil {
+		return nil
+	}
+	c := &BinarySetFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BinarySetFunction) cloneInto(c *BinarySetFunction) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the BlobFactor
+func (n *BlobFactor) Clone() *BlobFactor {
+	if n == nil {
+		return nil
+	}
+	c := &BlobFactor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BlobFactor) cloneInto(c *BlobFactor) {
+	*c = *n
+	n.Primary.cloneInto(&c.Primary)
+}
+
+// Clone returns a deep copy of the BlobPositionExpression
+func (n *BlobPositionExpression) Clone() *BlobPositionExpression {
+	if n == nil {
+		return nil
+	}
+	c := &BlobPositionExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BlobPositionExpression) cloneInto(c *BlobPositionExpression) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.In.cloneInto(&c.In)
+}
+
+// Clone returns a deep copy of the BlobPrimary
+func (n *BlobPrimary) Clone() *BlobPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &BlobPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BlobPrimary) cloneInto(c *BlobPrimary) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Function = n.Function.Clone()
+}
+
+// Clone returns a deep copy of the BlobValueExpression
+func (n *BlobValueExpression) Clone() *BlobValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &BlobValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BlobValueExpression) cloneInto(c *BlobValueExpression) {
+	*c = *n
+	c.Factor = n.Factor.Clone()
+}
+
+// Clone returns a deep copy of the BlobValueFunction
+func (n *BlobValueFunction) Clone() *BlobValueFunction {
+	if n == nil {
+		return nil
+	}
+	c := &BlobValueFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BlobValueFunction) cloneInto(c *BlobValueFunction) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the BooleanFactor
+func (n *BooleanFactor) Clone() *BooleanFactor {
+	if n == nil {
+		return nil
+	}
+	c := &BooleanFactor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BooleanFactor) cloneInto(c *BooleanFactor) {
+	*c = *n
+	n.Test.cloneInto(&c.Test)
+}
+
+// Clone returns a deep copy of the BooleanPredicand
+func (n *BooleanPredicand) Clone() *BooleanPredicand {
+	if n == nil {
+		return nil
+	}
+	c := &BooleanPredicand{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BooleanPredicand) cloneInto(c *BooleanPredicand) {
+	*c = *n
+	c.Parenthesized = n.Parenthesized.Clone()
+	c.Primary = n.Primary.Clone()
+}
+
+// Clone returns a deep copy of the BooleanPrimary
+func (n *BooleanPrimary) Clone() *BooleanPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &BooleanPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BooleanPrimary) cloneInto(c *BooleanPrimary) {
+	*c = *n
+	c.Predicate = n.Predicate.Clone()
+	c.Predicand = n.Predicand.Clone()
+}
+
+// Clone returns a deep copy of the BooleanTerm
+func (n *BooleanTerm) Clone() *BooleanTerm {
+	if n == nil {
+		return nil
+	}
+	c := &BooleanTerm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BooleanTerm) cloneInto(c *BooleanTerm) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.AndLeft = n.AndLeft.Clone()
+	c.AndRight = n.AndRight.Clone()
+}
+
+// Clone returns a deep copy of the BooleanTest
+func (n *BooleanTest) Clone() *BooleanTest {
+	if n == nil {
+		return nil
+	}
+	c := &BooleanTest{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BooleanTest) cloneInto(c *BooleanTest) {
+	*c = *n
+	n.Primary.cloneInto(&c.Primary)
+}
+
+// Clone returns a deep copy of the BooleanValueExpression
+func (n *BooleanValueExpression) Clone() *BooleanValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &BooleanValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *BooleanValueExpression) cloneInto(c *BooleanValueExpression) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.OrLeft = n.OrLeft.Clone()
+	c.OrRight = n.OrRight.Clone()
+}
+
+// Clone returns a deep copy of the CardinalityExpression
+func (n *CardinalityExpression) Clone() *CardinalityExpression {
+	if n == nil {
+		return nil
+	}
+	c := &CardinalityExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CardinalityExpression) cloneInto(c *CardinalityExpression) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the CeilingFunction
+func (n *CeilingFunction) Clone() *CeilingFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CeilingFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CeilingFunction) cloneInto(c *CeilingFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the CharacterFactor
+func (n *CharacterFactor) Clone() *CharacterFactor {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterFactor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterFactor) cloneInto(c *CharacterFactor) {
+	*c = *n
+	n.Primary.cloneInto(&c.Primary)
+	c.Collation = clonePointer(n.Collation)
+}
+
+// Clone returns a deep copy of the CharacterLengthExpression
+func (n *CharacterLengthExpression) Clone() *CharacterLengthExpression {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterLengthExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterLengthExpression) cloneInto(c *CharacterLengthExpression) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the CharacterOverlayFunction
+func (n *CharacterOverlayFunction) Clone() *CharacterOverlayFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterOverlayFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterOverlayFunction) cloneInto(c *CharacterOverlayFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.Placing.cloneInto(&c.Placing)
+	n.From.cloneInto(&c.From)
+	c.For = n.For.Clone()
+}
+
+// Clone returns a deep copy of the CharacterPrimary
+func (n *CharacterPrimary) Clone() *CharacterPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterPrimary) cloneInto(c *CharacterPrimary) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Function = n.Function.Clone()
+}
+
+// Clone returns a deep copy of the CharacterSubstringFunction
+func (n *CharacterSubstringFunction) Clone() *CharacterSubstringFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterSubstringFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterSubstringFunction) cloneInto(c *CharacterSubstringFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.From.cloneInto(&c.From)
+	c.For = n.For.Clone()
+}
+
+// Clone returns a deep copy of the CharacterTransliterationFunction
+func (n *CharacterTransliterationFunction) Clone() *CharacterTransliterationFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterTransliterationFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterTransliterationFunction) cloneInto(c *CharacterTransliterationFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.Using.cloneInto(&c.Using)
+}
+
+// Clone returns a deep copy of the CharacterValueExpression
+func (n *CharacterValueExpression) Clone() *CharacterValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterValueExpression) cloneInto(c *CharacterValueExpression) {
+	*c = *n
+	c.Concatenation = n.Concatenation.Clone()
+	c.Factor = n.Factor.Clone()
+}
+
+// Clone returns a deep copy of the CharacterValueFunction
+func (n *CharacterValueFunction) Clone() *CharacterValueFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CharacterValueFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CharacterValueFunction) cloneInto(c *CharacterValueFunction) {
+	*c = *n
+	c.Substring = n.Substring.Clone()
+	c.RegexSubstring = n.RegexSubstring.Clone()
+	c.Fold = n.Fold.Clone()
+	c.Transcoding = n.Transcoding.Clone()
+	c.Transliteration = n.Transliteration.Clone()
+	c.Trim = n.Trim.Clone()
+	c.Overlay = n.Overlay.Clone()
+	c.Normalize = n.Normalize.Clone()
+	c.SpecificType = n.SpecificType.Clone()
+}
+
+// Clone returns a deep copy of the ColumnReference
+func (n *ColumnReference) Clone() *ColumnReference {
+	if n == nil {
+		return nil
+	}
+	c := &ColumnReference{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ColumnReference) cloneInto(c *ColumnReference) {
+	*c = *n
+	c.BasicIdentifierChain = n.BasicIdentifierChain.Clone()
+}
+
+// Clone returns a deep copy of the CommonValueExpression
+func (n *CommonValueExpression) Clone() *CommonValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &CommonValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CommonValueExpression) cloneInto(c *CommonValueExpression) {
+	*c = *n
+	c.Numeric = n.Numeric.Clone()
+	c.String = n.String.Clone()
+	c.Datetime = n.Datetime.Clone()
+	c.Interval = n.Interval.Clone()
+}
+
+// Clone returns a deep copy of the ComparisonPredicate
+func (n *ComparisonPredicate) Clone() *ComparisonPredicate {
+	if n == nil {
+		return nil
+	}
+	c := &ComparisonPredicate{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ComparisonPredicate) cloneInto(c *ComparisonPredicate) {
+	*c = *n
+	n.A.cloneInto(&c.A)
+	n.B.cloneInto(&c.B)
+}
+
+// Clone returns a deep copy of the Concatenation
+func (n *Concatenation) Clone() *Concatenation {
+	if n == nil {
+		return nil
+	}
+	c := &Concatenation{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Concatenation) cloneInto(c *Concatenation) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the Correlation
+func (n *Correlation) Clone() *Correlation {
+	if n == nil {
+		return nil
+	}
+	c := &Correlation{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Correlation) cloneInto(c *Correlation) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the CrossJoin
+func (n *CrossJoin) Clone() *CrossJoin {
+	if n == nil {
+		return nil
+	}
+	c := &CrossJoin{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CrossJoin) cloneInto(c *CrossJoin) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the CurrentTimeFunction
+func (n *CurrentTimeFunction) Clone() *CurrentTimeFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CurrentTimeFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CurrentTimeFunction) cloneInto(c *CurrentTimeFunction) {
+	*c = *n
+	c.Precision = clonePointer(n.Precision)
+}
+
+// Clone returns a deep copy of the CurrentTimestampFunction
+func (n *CurrentTimestampFunction) Clone() *CurrentTimestampFunction {
+	if n == nil {
+		return nil
+	}
+	c := &CurrentTimestampFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CurrentTimestampFunction) cloneInto(c *CurrentTimestampFunction) {
+	*c = *n
+	c.Precision = clonePointer(n.Precision)
+}
+
+// Clone returns a deep copy of the CursorSpecification
+func (n *CursorSpecification) Clone() *CursorSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &CursorSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *CursorSpecification) cloneInto(c *CursorSpecification) {
+	*c = *n
+	n.Query.cloneInto(&c.Query)
+	c.OrderBy = n.OrderBy.Clone()
+	c.Limit = n.Limit.Clone()
+}
+
+// Clone returns a deep copy of the DatetimeFactor
+func (n *DatetimeFactor) Clone() *DatetimeFactor {
+	if n == nil {
+		return nil
+	}
+	c := &DatetimeFactor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DatetimeFactor) cloneInto(c *DatetimeFactor) {
+	*c = *n
+	n.Primary.cloneInto(&c.Primary)
+	c.Timezone = n.Timezone.Clone()
+}
+
+// Clone returns a deep copy of the DatetimePrimary
+func (n *DatetimePrimary) Clone() *DatetimePrimary {
+	if n == nil {
+		return nil
+	}
+	c := &DatetimePrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DatetimePrimary) cloneInto(c *DatetimePrimary) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Function = n.Function.Clone()
+}
+
+// Clone returns a deep copy of the DatetimeTerm
+func (n *DatetimeTerm) Clone() *DatetimeTerm {
+	if n == nil {
+		return nil
+	}
+	c := &DatetimeTerm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DatetimeTerm) cloneInto(c *DatetimeTerm) {
+	*c = *n
+	n.Factor.cloneInto(&c.Factor)
+}
+
+// Clone returns a deep copy of the DatetimeValueExpression
+func (n *DatetimeValueExpression) Clone() *DatetimeValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &DatetimeValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DatetimeValueExpression) cloneInto(c *DatetimeValueExpression) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.AddInterval = n.AddInterval.Clone()
+	c.AddSubtract = n.AddSubtract.Clone()
+}
+
+// Clone returns a deep copy of the DatetimeValueFunction
+func (n *DatetimeValueFunction) Clone() *DatetimeValueFunction {
+	if n == nil {
+		return nil
+	}
+	c := &DatetimeValueFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DatetimeValueFunction) cloneInto(c *DatetimeValueFunction) {
+	*c = *n
+	c.CurrentTime = n.CurrentTime.Clone()
+	c.LocalTime = n.LocalTime.Clone()
+	c.CurrentTimestamp = n.CurrentTimestamp.Clone()
+	c.LocalTimestamp = n.LocalTimestamp.Clone()
+}
+
+// Clone returns a deep copy of the DeleteStatementSearched
+func (n *DeleteStatementSearched) Clone() *DeleteStatementSearched {
+	if n == nil {
+		return nil
+	}
+	c := &DeleteStatementSearched{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DeleteStatementSearched) cloneInto(c *DeleteStatementSearched) {
+	*c = *n
+	c.SchemaName = clonePointer(n.SchemaName)
+	if n.Using != nil {
+		c.Using = make([]TableReference, len(n.Using))
+		for x := range n.Using {
+			n.Using[x].cloneInto(&c.Using[x])
+		}
+	}
+	c.Where = n.Where.Clone()
+	c.OrderBy = n.OrderBy.Clone()
+	c.Limit = n.Limit.Clone()
+	c.Returning = n.Returning.Clone()
+}
+
+// Clone returns a deep copy of the DerivedColumn
+func (n *DerivedColumn) Clone() *DerivedColumn {
+	if n == nil {
+		return nil
+	}
+	c := &DerivedColumn{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DerivedColumn) cloneInto(c *DerivedColumn) {
+	*c = *n
+	n.Value.cloneInto(&c.Value)
+	c.As = clonePointer(n.As)
+}
+
+// Clone returns a deep copy of the DerivedTable
+func (n *DerivedTable) Clone() *DerivedTable {
+	if n == nil {
+		return nil
+	}
+	c := &DerivedTable{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *DerivedTable) cloneInto(c *DerivedTable) {
+	*c = *n
+	n.Subquery.cloneInto(&c.Subquery)
+}
+
+// Clone returns a deep copy of the EndField
+func (n *EndField) Clone() *EndField {
+	if n == nil {
+		return nil
+	}
+	c := &EndField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *EndField) cloneInto(c *EndField) {
+	*c = *n
+	c.Nonsecond = clonePointer(n.Nonsecond)
+	c.Second = n.Second.Clone()
+}
+
+// Clone returns a deep copy of the ExponentialFunction
+func (n *ExponentialFunction) Clone() *ExponentialFunction {
+	if n == nil {
+		return nil
+	}
+	c := &ExponentialFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ExponentialFunction) cloneInto(c *ExponentialFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the ExtractExpression
+func (n *ExtractExpression) Clone() *ExtractExpression {
+	if n == nil {
+		return nil
+	}
+	c := &ExtractExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ExtractExpression) cloneInto(c *ExtractExpression) {
+	*c = *n
+	n.What.cloneInto(&c.What)
+	n.From.cloneInto(&c.From)
+}
+
+// Clone returns a deep copy of the ExtractField
+func (n *ExtractField) Clone() *ExtractField {
+	if n == nil {
+		return nil
+	}
+	c := &ExtractField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ExtractField) cloneInto(c *ExtractField) {
+	*c = *n
+	c.Datetime = n.Datetime.Clone()
+	c.Timezone = clonePointer(n.Timezone)
+}
+
+// Clone returns a deep copy of the ExtractSource
+func (n *ExtractSource) Clone() *ExtractSource {
+	if n == nil {
+		return nil
+	}
+	c := &ExtractSource{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ExtractSource) cloneInto(c *ExtractSource) {
+	*c = *n
+	c.Datetime = n.Datetime.Clone()
+	c.Interval = n.Interval.Clone()
+}
+
+// Clone returns a deep copy of the Factor
+func (n *Factor) Clone() *Factor {
+	if n == nil {
+		return nil
+	}
+	c := &Factor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Factor) cloneInto(c *Factor) {
+	*c = *n
+	n.Primary.cloneInto(&c.Primary)
+}
+
+// Clone returns a deep copy of the FloorFunction
+func (n *FloorFunction) Clone() *FloorFunction {
+	if n == nil {
+		return nil
+	}
+	c := &FloorFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *FloorFunction) cloneInto(c *FloorFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the FoldFunction
+func (n *FoldFunction) Clone() *FoldFunction {
+	if n == nil {
+		return nil
+	}
+	c := &FoldFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *FoldFunction) cloneInto(c *FoldFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the FromClause
+func (n *FromClause) Clone() *FromClause {
+	if n == nil {
+		return nil
+	}
+	c := &FromClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *FromClause) cloneInto(c *FromClause) {
+	*c = *n
+	if n.TableReferences != nil {
+		c.TableReferences = make([]TableReference, len(n.TableReferences))
+		for x := range n.TableReferences {
+			n.TableReferences[x].cloneInto(&c.TableReferences[x])
+		}
+	}
+}
+
+// Clone returns a deep copy of the GeneralLiteral
+func (n *GeneralLiteral) Clone() *GeneralLiteral {
+	if n == nil {
+		return nil
+	}
+	c := &GeneralLiteral{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *GeneralLiteral) cloneInto(c *GeneralLiteral) {
+	*c = *n
+	c.Value = cloneAny(n.Value)
+}
+
+// Clone returns a deep copy of the GeneralSetFunction
+func (n *GeneralSetFunction) Clone() *GeneralSetFunction {
+	if n == nil {
+		return nil
+	}
+	c := &GeneralSetFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *GeneralSetFunction) cloneInto(c *GeneralSetFunction) {
+	*c = *n
+	n.Value.cloneInto(&c.Value)
+}
+
+// Clone returns a deep copy of the GeneralValueSpecification
+func (n *GeneralValueSpecification) Clone() *GeneralValueSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &GeneralValueSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *GeneralValueSpecification) cloneInto(c *GeneralValueSpecification) {
+	*c = *n
+	c.HostParameter = n.HostParameter.Clone()
+}
+
+// Clone returns a deep copy of the GroupByClause
+func (n *GroupByClause) Clone() *GroupByClause {
+	if n == nil {
+		return nil
+	}
+	c := &GroupByClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *GroupByClause) cloneInto(c *GroupByClause) {
+	*c = *n
+	if n.GroupingElements != nil {
+		c.GroupingElements = make([]GroupingElement, len(n.GroupingElements))
+		for x := range n.GroupingElements {
+			n.GroupingElements[x].cloneInto(&c.GroupingElements[x])
+		}
+	}
+}
+
+// Clone returns a deep copy of the GroupingColumnReference
+func (n *GroupingColumnReference) Clone() *GroupingColumnReference {
+	if n == nil {
+		return nil
+	}
+	c := &GroupingColumnReference{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *GroupingColumnReference) cloneInto(c *GroupingColumnReference) {
+	*c = *n
+	c.ColumnReference = n.ColumnReference.Clone()
+	c.Collation = clonePointer(n.Collation)
+}
+
+// Clone returns a deep copy of the GroupingElement
+func (n *GroupingElement) Clone() *GroupingElement {
+	if n == nil {
+		return nil
+	}
+	c := &GroupingElement{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *GroupingElement) cloneInto(c *GroupingElement) {
+	*c = *n
+	c.OrdinaryGroupingSet = n.OrdinaryGroupingSet.Clone()
+}
+
+// Clone returns a deep copy of the HavingClause
+func (n *HavingClause) Clone() *HavingClause {
+	if n == nil {
+		return nil
+	}
+	c := &HavingClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *HavingClause) cloneInto(c *HavingClause) {
+	*c = *n
+	n.Search.cloneInto(&c.Search)
+}
+
+// Clone returns a deep copy of the HostParameterSpecification
+func (n *HostParameterSpecification) Clone() *HostParameterSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &HostParameterSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *HostParameterSpecification) cloneInto(c *HostParameterSpecification) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the IdentifierChain
+func (n *IdentifierChain) Clone() *IdentifierChain {
+	if n == nil {
+		return nil
+	}
+	c := &IdentifierChain{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IdentifierChain) cloneInto(c *IdentifierChain) {
+	*c = *n
+	c.Identifiers = slices.Clone(n.Identifiers)
+}
+
+// Clone returns a deep copy of the InPredicate
+func (n *InPredicate) Clone() *InPredicate {
+	if n == nil {
+		return nil
+	}
+	c := &InPredicate{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *InPredicate) cloneInto(c *InPredicate) {
+	*c = *n
+	n.Target.cloneInto(&c.Target)
+	if n.Values != nil {
+		c.Values = make([]RowValueExpression, len(n.Values))
+		for x := range n.Values {
+			n.Values[x].cloneInto(&c.Values[x])
+		}
+	}
+}
+
+// Clone returns a deep copy of the InsertStatement
+func (n *InsertStatement) Clone() *InsertStatement {
+	if n == nil {
+		return nil
+	}
+	c := &InsertStatement{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *InsertStatement) cloneInto(c *InsertStatement) {
+	*c = *n
+	c.SchemaName = clonePointer(n.SchemaName)
+	c.Columns = slices.Clone(n.Columns)
+	if n.Values != nil {
+		c.Values = make([]interface{}, len(n.Values))
+		for x, e := range n.Values {
+			c.Values[x] = cloneAny(e)
+		}
+	}
+	c.Returning = n.Returning.Clone()
+}
+
+// Clone returns a deep copy of the IntersectQuery
+func (n *IntersectQuery) Clone() *IntersectQuery {
+	if n == nil {
+		return nil
+	}
+	c := &IntersectQuery{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntersectQuery) cloneInto(c *IntersectQuery) {
+	*c = *n
+	n.Term.cloneInto(&c.Term)
+	n.Primary.cloneInto(&c.Primary)
+}
+
+// Clone returns a deep copy of the IntervalFactor
+func (n *IntervalFactor) Clone() *IntervalFactor {
+	if n == nil {
+		return nil
+	}
+	c := &IntervalFactor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntervalFactor) cloneInto(c *IntervalFactor) {
+	*c = *n
+	n.Primary.cloneInto(&c.Primary)
+}
+
+// Clone returns a deep copy of the IntervalPrimary
+func (n *IntervalPrimary) Clone() *IntervalPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &IntervalPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntervalPrimary) cloneInto(c *IntervalPrimary) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Qualifier = n.Qualifier.Clone()
+	c.Function = n.Function.Clone()
+}
+
+// Clone returns a deep copy of the IntervalQualifier
+func (n *IntervalQualifier) Clone() *IntervalQualifier {
+	if n == nil {
+		return nil
+	}
+	c := &IntervalQualifier{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntervalQualifier) cloneInto(c *IntervalQualifier) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.StartEnd = n.StartEnd.Clone()
+}
+
+// Clone returns a deep copy of the IntervalTerm
+func (n *IntervalTerm) Clone() *IntervalTerm {
+	if n == nil {
+		return nil
+	}
+	c := &IntervalTerm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntervalTerm) cloneInto(c *IntervalTerm) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.MultiplyDivide = n.MultiplyDivide.Clone()
+	c.MultiplyNumeric = n.MultiplyNumeric.Clone()
+}
+
+// Clone returns a deep copy of the IntervalValueExpression
+func (n *IntervalValueExpression) Clone() *IntervalValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &IntervalValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntervalValueExpression) cloneInto(c *IntervalValueExpression) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.AddSubtract = n.AddSubtract.Clone()
+	c.SubtractDatetime = n.SubtractDatetime.Clone()
+}
+
+// Clone returns a deep copy of the IntervalValueFunction
+func (n *IntervalValueFunction) Clone() *IntervalValueFunction {
+	if n == nil {
+		return nil
+	}
+	c := &IntervalValueFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *IntervalValueFunction) cloneInto(c *IntervalValueFunction) {
+	*c = *n
+	c.Abs = n.Abs.Clone()
+}
+
+// Clone returns a deep copy of the JoinedTable
+func (n *JoinedTable) Clone() *JoinedTable {
+	if n == nil {
+		return nil
+	}
+	c := &JoinedTable{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *JoinedTable) cloneInto(c *JoinedTable) {
+	*c = *n
+	c.Cross = n.Cross.Clone()
+	c.Qualified = n.Qualified.Clone()
+	c.Natural = n.Natural.Clone()
+	c.Union = n.Union.Clone()
+}
+
+// Clone returns a deep copy of the LengthExpression
+func (n *LengthExpression) Clone() *LengthExpression {
+	if n == nil {
+		return nil
+	}
+	c := &LengthExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *LengthExpression) cloneInto(c *LengthExpression) {
+	*c = *n
+	c.Character = n.Character.Clone()
+	c.Octet = n.Octet.Clone()
+}
+
+// Clone returns a deep copy of the LimitClause
+func (n *LimitClause) Clone() *LimitClause {
+	if n == nil {
+		return nil
+	}
+	c := &LimitClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *LimitClause) cloneInto(c *LimitClause) {
+	*c = *n
+	c.Offset = clonePointer(n.Offset)
+}
+
+// Clone returns a deep copy of the Literal
+func (n *Literal) Clone() *Literal {
+	if n == nil {
+		return nil
+	}
+	c := &Literal{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Literal) cloneInto(c *Literal) {
+	*c = *n
+	c.SignedNumeric = n.SignedNumeric.Clone()
+	c.General = n.General.Clone()
+}
+
+// Clone returns a deep copy of the LocalTimeFunction
+func (n *LocalTimeFunction) Clone() *LocalTimeFunction {
+	if n == nil {
+		return nil
+	}
+	c := &LocalTimeFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *LocalTimeFunction) cloneInto(c *LocalTimeFunction) {
+	*c = *n
+	c.Precision = clonePointer(n.Precision)
+}
+
+// Clone returns a deep copy of the LocalTimestampFunction
+func (n *LocalTimestampFunction) Clone() *LocalTimestampFunction {
+	if n == nil {
+		return nil
+	}
+	c := &LocalTimestampFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *LocalTimestampFunction) cloneInto(c *LocalTimestampFunction) {
+	*c = *n
+	c.Precision = clonePointer(n.Precision)
+}
+
+// Clone returns a deep copy of the ModulusExpression
+func (n *ModulusExpression) Clone() *ModulusExpression {
+	if n == nil {
+		return nil
+	}
+	c := &ModulusExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ModulusExpression) cloneInto(c *ModulusExpression) {
+	*c = *n
+	n.Dividend.cloneInto(&c.Dividend)
+	n.Divisor.cloneInto(&c.Divisor)
+}
+
+// Clone returns a deep copy of the MultiplyDivideExpression
+func (n *MultiplyDivideExpression) Clone() *MultiplyDivideExpression {
+	if n == nil {
+		return nil
+	}
+	c := &MultiplyDivideExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *MultiplyDivideExpression) cloneInto(c *MultiplyDivideExpression) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the MultiplyDivideIntervalTerm
+func (n *MultiplyDivideIntervalTerm) Clone() *MultiplyDivideIntervalTerm {
+	if n == nil {
+		return nil
+	}
+	c := &MultiplyDivideIntervalTerm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *MultiplyDivideIntervalTerm) cloneInto(c *MultiplyDivideIntervalTerm) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the MultiplyNumericIntervalFactor
+func (n *MultiplyNumericIntervalFactor) Clone() *MultiplyNumericIntervalFactor {
+	if n == nil {
+		return nil
+	}
+	c := &MultiplyNumericIntervalFactor{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *MultiplyNumericIntervalFactor) cloneInto(c *MultiplyNumericIntervalFactor) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the NaturalJoin
+func (n *NaturalJoin) Clone() *NaturalJoin {
+	if n == nil {
+		return nil
+	}
+	c := &NaturalJoin{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NaturalJoin) cloneInto(c *NaturalJoin) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the NaturalLogarithm
+func (n *NaturalLogarithm) Clone() *NaturalLogarithm {
+	if n == nil {
+		return nil
+	}
+	c := &NaturalLogarithm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NaturalLogarithm) cloneInto(c *NaturalLogarithm) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the NonJoinQueryExpression
+func (n *NonJoinQueryExpression) Clone() *NonJoinQueryExpression {
+	if n == nil {
+		return nil
+	}
+	c := &NonJoinQueryExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NonJoinQueryExpression) cloneInto(c *NonJoinQueryExpression) {
+	*c = *n
+	c.NonJoin = n.NonJoin.Clone()
+}
+
+// Clone returns a deep copy of the NonJoinQueryPrimary
+func (n *NonJoinQueryPrimary) Clone() *NonJoinQueryPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &NonJoinQueryPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NonJoinQueryPrimary) cloneInto(c *NonJoinQueryPrimary) {
+	*c = *n
+	c.Simple = n.Simple.Clone()
+	c.Parenthesized = n.Parenthesized.Clone()
+}
+
+// Clone returns a deep copy of the NonJoinQueryTerm
+func (n *NonJoinQueryTerm) Clone() *NonJoinQueryTerm {
+	if n == nil {
+		return nil
+	}
+	c := &NonJoinQueryTerm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NonJoinQueryTerm) cloneInto(c *NonJoinQueryTerm) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Intersect = n.Intersect.Clone()
+}
+
+// Clone returns a deep copy of the NonParenthesizedValueExpressionPrimary
+func (n *NonParenthesizedValueExpressionPrimary) Clone() *NonParenthesizedValueExpressionPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &NonParenthesizedValueExpressionPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NonParenthesizedValueExpressionPrimary) cloneInto(c *NonParenthesizedValueExpressionPrimary) {
+	*c = *n
+	c.UnsignedValue = n.UnsignedValue.Clone()
+	c.ColumnReference = n.ColumnReference.Clone()
+	c.SetFunction = n.SetFunction.Clone()
+	c.ScalarSubquery = n.ScalarSubquery.Clone()
+}
+
+// Clone returns a deep copy of the NormalizeFunction
+func (n *NormalizeFunction) Clone() *NormalizeFunction {
+	if n == nil {
+		return nil
+	}
+	c := &NormalizeFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NormalizeFunction) cloneInto(c *NormalizeFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the NullPredicate
+func (n *NullPredicate) Clone() *NullPredicate {
+	if n == nil {
+		return nil
+	}
+	c := &NullPredicate{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NullPredicate) cloneInto(c *NullPredicate) {
+	*c = *n
+	n.Target.cloneInto(&c.Target)
+}
+
+// Clone returns a deep copy of the NumericPrimary
+func (n *NumericPrimary) Clone() *NumericPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &NumericPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NumericPrimary) cloneInto(c *NumericPrimary) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Function = n.Function.Clone()
+}
+
+// Clone returns a deep copy of the NumericValueExpression
+func (n *NumericValueExpression) Clone() *NumericValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &NumericValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NumericValueExpression) cloneInto(c *NumericValueExpression) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.AddSubtract = n.AddSubtract.Clone()
+}
+
+// Clone returns a deep copy of the NumericValueFunction
+func (n *NumericValueFunction) Clone() *NumericValueFunction {
+	if n == nil {
+		return nil
+	}
+	c := &NumericValueFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *NumericValueFunction) cloneInto(c *NumericValueFunction) {
+	*c = *n
+	c.Position = n.Position.Clone()
+	c.Extract = n.Extract.Clone()
+	c.Length = n.Length.Clone()
+	c.Cardinality = n.Cardinality.Clone()
+	c.AbsoluteValue = n.AbsoluteValue.Clone()
+	c.Modulus = n.Modulus.Clone()
+	c.Natural = n.Natural.Clone()
+	c.Exponential = n.Exponential.Clone()
+	c.Power = n.Power.Clone()
+	c.SquareRoot = n.SquareRoot.Clone()
+	c.Floor = n.Floor.Clone()
+	c.Ceiling = n.Ceiling.Clone()
+	c.WidthBucket = n.WidthBucket.Clone()
+}
+
+// Clone returns a deep copy of the OctetLengthExpression
+func (n *OctetLengthExpression) Clone() *OctetLengthExpression {
+	if n == nil {
+		return nil
+	}
+	c := &OctetLengthExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *OctetLengthExpression) cloneInto(c *OctetLengthExpression) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the OrderByClause
+func (n *OrderByClause) Clone() *OrderByClause {
+	if n == nil {
+		return nil
+	}
+	c := &OrderByClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *OrderByClause) cloneInto(c *OrderByClause) {
+	*c = *n
+	if n.SortSpecifications != nil {
+		c.SortSpecifications = make([]SortSpecification, len(n.SortSpecifications))
+		for x := range n.SortSpecifications {
+			n.SortSpecifications[x].cloneInto(&c.SortSpecifications[x])
+		}
+	}
+}
+
+// Clone returns a deep copy of the OrderedSetFunction
+func (n *OrderedSetFunction) Clone() *OrderedSetFunction {
+	if n == nil {
+		return nil
+	}
+	c := &OrderedSetFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *OrderedSetFunction) cloneInto(c *OrderedSetFunction) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the OrdinaryGroupingSet
+func (n *OrdinaryGroupingSet) Clone() *OrdinaryGroupingSet {
+	if n == nil {
+		return nil
+	}
+	c := &OrdinaryGroupingSet{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *OrdinaryGroupingSet) cloneInto(c *OrdinaryGroupingSet) {
+	*c = *n
+	c.GroupingColumnReference = n.GroupingColumnReference.Clone()
+}
+
+// Clone returns a deep copy of the PositionExpression
+func (n *PositionExpression) Clone() *PositionExpression {
+	if n == nil {
+		return nil
+	}
+	c := &PositionExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *PositionExpression) cloneInto(c *PositionExpression) {
+	*c = *n
+	c.String = n.String.Clone()
+	c.Blob = n.Blob.Clone()
+}
+
+// Clone returns a deep copy of the PowerFunction
+func (n *PowerFunction) Clone() *PowerFunction {
+	if n == nil {
+		return nil
+	}
+	c := &PowerFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *PowerFunction) cloneInto(c *PowerFunction) {
+	*c = *n
+	n.Base.cloneInto(&c.Base)
+	n.Exponent.cloneInto(&c.Exponent)
+}
+
+// Clone returns a deep copy of the Predicate
+func (n *Predicate) Clone() *Predicate {
+	if n == nil {
+		return nil
+	}
+	c := &Predicate{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Predicate) cloneInto(c *Predicate) {
+	*c = *n
+	c.Comparison = n.Comparison.Clone()
+	c.Between = n.Between.Clone()
+	c.In = n.In.Clone()
+	c.Null = n.Null.Clone()
+}
+
+// Clone returns a deep copy of the PrimaryDatetimeField
+func (n *PrimaryDatetimeField) Clone() *PrimaryDatetimeField {
+	if n == nil {
+		return nil
+	}
+	c := &PrimaryDatetimeField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *PrimaryDatetimeField) cloneInto(c *PrimaryDatetimeField) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the QualifiedJoin
+func (n *QualifiedJoin) Clone() *QualifiedJoin {
+	if n == nil {
+		return nil
+	}
+	c := &QualifiedJoin{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *QualifiedJoin) cloneInto(c *QualifiedJoin) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+	n.On.cloneInto(&c.On)
+}
+
+// Clone returns a deep copy of the QueryExpression
+func (n *QueryExpression) Clone() *QueryExpression {
+	if n == nil {
+		return nil
+	}
+	c := &QueryExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *QueryExpression) cloneInto(c *QueryExpression) {
+	*c = *n
+	n.Body.cloneInto(&c.Body)
+}
+
+// Clone returns a deep copy of the QueryExpressionBody
+func (n *QueryExpressionBody) Clone() *QueryExpressionBody {
+	if n == nil {
+		return nil
+	}
+	c := &QueryExpressionBody{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *QueryExpressionBody) cloneInto(c *QueryExpressionBody) {
+	*c = *n
+	c.NonJoin = n.NonJoin.Clone()
+	c.Joined = n.Joined.Clone()
+}
+
+// Clone returns a deep copy of the QueryPrimary
+func (n *QueryPrimary) Clone() *QueryPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &QueryPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *QueryPrimary) cloneInto(c *QueryPrimary) {
+	*c = *n
+	c.NonJoinQueryPrimary = n.NonJoinQueryPrimary.Clone()
+	c.JoinedTable = n.JoinedTable.Clone()
+}
+
+// Clone returns a deep copy of the QuerySpecification
+func (n *QuerySpecification) Clone() *QuerySpecification {
+	if n == nil {
+		return nil
+	}
+	c := &QuerySpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *QuerySpecification) cloneInto(c *QuerySpecification) {
+	*c = *n
+	n.SelectList.cloneInto(&c.SelectList)
+	n.TableExpression.cloneInto(&c.TableExpression)
+}
+
+// Clone returns a deep copy of the QueryTerm
+func (n *QueryTerm) Clone() *QueryTerm {
+	if n == nil {
+		return nil
+	}
+	c := &QueryTerm{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *QueryTerm) cloneInto(c *QueryTerm) {
+	*c = *n
+	c.NonJoinQueryTerm = n.NonJoinQueryTerm.Clone()
+	c.JoinedTable = n.JoinedTable.Clone()
+}
+
+// Clone returns a deep copy of the RegexSubstringFunction
+func (n *RegexSubstringFunction) Clone() *RegexSubstringFunction {
+	if n == nil {
+		return nil
+	}
+	c := &RegexSubstringFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *RegexSubstringFunction) cloneInto(c *RegexSubstringFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.Similar.cloneInto(&c.Similar)
+	n.Escape.cloneInto(&c.Escape)
+}
+
+// Clone returns a deep copy of the ReturningClause
+func (n *ReturningClause) Clone() *ReturningClause {
+	if n == nil {
+		return nil
+	}
+	c := &ReturningClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ReturningClause) cloneInto(c *ReturningClause) {
+	*c = *n
+	c.Columns = slices.Clone(n.Columns)
+}
+
+// Clone returns a deep copy of the RowValueExpression
+func (n *RowValueExpression) Clone() *RowValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &RowValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *RowValueExpression) cloneInto(c *RowValueExpression) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+}
+
+// Clone returns a deep copy of the RowValuePredicand
+func (n *RowValuePredicand) Clone() *RowValuePredicand {
+	if n == nil {
+		return nil
+	}
+	c := &RowValuePredicand{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *RowValuePredicand) cloneInto(c *RowValuePredicand) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Common = n.Common.Clone()
+	c.Boolean = n.Boolean.Clone()
+}
+
+// Clone returns a deep copy of the SchemaQualifiedName
+func (n *SchemaQualifiedName) Clone() *SchemaQualifiedName {
+	if n == nil {
+		return nil
+	}
+	c := &SchemaQualifiedName{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SchemaQualifiedName) cloneInto(c *SchemaQualifiedName) {
+	*c = *n
+	c.SchemaName = clonePointer(n.SchemaName)
+	n.Identifiers.cloneInto(&c.Identifiers)
+}
+
+// Clone returns a deep copy of the SecondPrimaryDatetimeField
+func (n *SecondPrimaryDatetimeField) Clone() *SecondPrimaryDatetimeField {
+	if n == nil {
+		return nil
+	}
+	c := &SecondPrimaryDatetimeField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SecondPrimaryDatetimeField) cloneInto(c *SecondPrimaryDatetimeField) {
+	*c = *n
+	c.Precision = clonePointer(n.Precision)
+	c.FractionalPrecision = clonePointer(n.FractionalPrecision)
+}
+
+// Clone returns a deep copy of the SelectList
+func (n *SelectList) Clone() *SelectList {
+	if n == nil {
+		return nil
+	}
+	c := &SelectList{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SelectList) cloneInto(c *SelectList) {
+	*c = *n
+	if n.Sublists != nil {
+		c.Sublists = make([]SelectSublist, len(n.Sublists))
+		for x := range n.Sublists {
+			n.Sublists[x].cloneInto(&c.Sublists[x])
+		}
+	}
+}
+
+// Clone returns a deep copy of the SelectSublist
+func (n *SelectSublist) Clone() *SelectSublist {
+	if n == nil {
+		return nil
+	}
+	c := &SelectSublist{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SelectSublist) cloneInto(c *SelectSublist) {
+	*c = *n
+	c.DerivedColumn = n.DerivedColumn.Clone()
+}
+
+// Clone returns a deep copy of the SetFunctionSpecification
+func (n *SetFunctionSpecification) Clone() *SetFunctionSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &SetFunctionSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SetFunctionSpecification) cloneInto(c *SetFunctionSpecification) {
+	*c = *n
+	c.Aggregate = n.Aggregate.Clone()
+}
+
+// Clone returns a deep copy of the SignedNumericLiteral
+func (n *SignedNumericLiteral) Clone() *SignedNumericLiteral {
+	if n == nil {
+		return nil
+	}
+	c := &SignedNumericLiteral{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SignedNumericLiteral) cloneInto(c *SignedNumericLiteral) {
+	*c = *n
+	c.Value = cloneAny(n.Value)
+}
+
+// Clone returns a deep copy of the SimpleTable
+func (n *SimpleTable) Clone() *SimpleTable {
+	if n == nil {
+		return nil
+	}
+	c := &SimpleTable{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SimpleTable) cloneInto(c *SimpleTable) {
+	*c = *n
+	c.QuerySpecification = n.QuerySpecification.Clone()
+}
+
+// Clone returns a deep copy of the SingleDatetimeField
+func (n *SingleDatetimeField) Clone() *SingleDatetimeField {
+	if n == nil {
+		return nil
+	}
+	c := &SingleDatetimeField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SingleDatetimeField) cloneInto(c *SingleDatetimeField) {
+	*c = *n
+	c.Nonsecond = clonePointer(n.Nonsecond)
+}
+
+// Clone returns a deep copy of the SortSpecification
+func (n *SortSpecification) Clone() *SortSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &SortSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SortSpecification) cloneInto(c *SortSpecification) {
+	*c = *n
+	n.Key.cloneInto(&c.Key)
+}
+
+// Clone returns a deep copy of the SpecificTypeFunction
+func (n *SpecificTypeFunction) Clone() *SpecificTypeFunction {
+	if n == nil {
+		return nil
+	}
+	c := &SpecificTypeFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SpecificTypeFunction) cloneInto(c *SpecificTypeFunction) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the SquareRoot
+func (n *SquareRoot) Clone() *SquareRoot {
+	if n == nil {
+		return nil
+	}
+	c := &SquareRoot{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SquareRoot) cloneInto(c *SquareRoot) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the StartEndDatetimeField
+func (n *StartEndDatetimeField) Clone() *StartEndDatetimeField {
+	if n == nil {
+		return nil
+	}
+	c := &StartEndDatetimeField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *StartEndDatetimeField) cloneInto(c *StartEndDatetimeField) {
+	*c = *n
+	n.Start.cloneInto(&c.Start)
+	n.End.cloneInto(&c.End)
+}
+
+// Clone returns a deep copy of the StartField
+func (n *StartField) Clone() *StartField {
+	if n == nil {
+		return nil
+	}
+	c := &StartField{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *StartField) cloneInto(c *StartField) {
+	*c = *n
+}
+
+// Clone returns a deep copy of the StringPositionExpression
+func (n *StringPositionExpression) Clone() *StringPositionExpression {
+	if n == nil {
+		return nil
+	}
+	c := &StringPositionExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *StringPositionExpression) cloneInto(c *StringPositionExpression) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.In.cloneInto(&c.In)
+}
+
+// Clone returns a deep copy of the StringValueExpression
+func (n *StringValueExpression) Clone() *StringValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &StringValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *StringValueExpression) cloneInto(c *StringValueExpression) {
+	*c = *n
+	c.Character = n.Character.Clone()
+	c.Blob = n.Blob.Clone()
+}
+
+// Clone returns a deep copy of the StringValueFunction
+func (n *StringValueFunction) Clone() *StringValueFunction {
+	if n == nil {
+		return nil
+	}
+	c := &StringValueFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *StringValueFunction) cloneInto(c *StringValueFunction) {
+	*c = *n
+	c.Character = n.Character.Clone()
+	c.Blob = n.Blob.Clone()
+}
+
+// Clone returns a deep copy of the Subquery
+func (n *Subquery) Clone() *Subquery {
+	if n == nil {
+		return nil
+	}
+	c := &Subquery{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Subquery) cloneInto(c *Subquery) {
+	*c = *n
+	n.QueryExpression.cloneInto(&c.QueryExpression)
+}
+
+// Clone returns a deep copy of the SubtractDatetimeExpression
+func (n *SubtractDatetimeExpression) Clone() *SubtractDatetimeExpression {
+	if n == nil {
+		return nil
+	}
+	c := &SubtractDatetimeExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *SubtractDatetimeExpression) cloneInto(c *SubtractDatetimeExpression) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the TableExpression
+func (n *TableExpression) Clone() *TableExpression {
+	if n == nil {
+		return nil
+	}
+	c := &TableExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TableExpression) cloneInto(c *TableExpression) {
+	*c = *n
+	n.From.cloneInto(&c.From)
+	c.Where = n.Where.Clone()
+	c.GroupBy = n.GroupBy.Clone()
+	c.Having = n.Having.Clone()
+}
+
+// Clone returns a deep copy of the TablePrimary
+func (n *TablePrimary) Clone() *TablePrimary {
+	if n == nil {
+		return nil
+	}
+	c := &TablePrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TablePrimary) cloneInto(c *TablePrimary) {
+	*c = *n
+	c.SchemaName = clonePointer(n.SchemaName)
+	c.TableName = clonePointer(n.TableName)
+	c.QueryName = clonePointer(n.QueryName)
+	c.DerivedTable = n.DerivedTable.Clone()
+	c.Correlation = n.Correlation.Clone()
+}
+
+// Clone returns a deep copy of the TableReference
+func (n *TableReference) Clone() *TableReference {
+	if n == nil {
+		return nil
+	}
+	c := &TableReference{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TableReference) cloneInto(c *TableReference) {
+	*c = *n
+	c.Primary = n.Primary.Clone()
+	c.Joined = n.Joined.Clone()
+}
+
+// Clone returns a deep copy of the Term
+func (n *Term) Clone() *Term {
+	if n == nil {
+		return nil
+	}
+	c := &Term{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *Term) cloneInto(c *Term) {
+	*c = *n
+	c.Unary = n.Unary.Clone()
+	c.MultiplyDivide = n.MultiplyDivide.Clone()
+}
+
+// Clone returns a deep copy of the TimezoneSpecifier
+func (n *TimezoneSpecifier) Clone() *TimezoneSpecifier {
+	if n == nil {
+		return nil
+	}
+	c := &TimezoneSpecifier{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TimezoneSpecifier) cloneInto(c *TimezoneSpecifier) {
+	*c = *n
+	c.Timezone = n.Timezone.Clone()
+}
+
+// Clone returns a deep copy of the TranscodingFunction
+func (n *TranscodingFunction) Clone() *TranscodingFunction {
+	if n == nil {
+		return nil
+	}
+	c := &TranscodingFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TranscodingFunction) cloneInto(c *TranscodingFunction) {
+	*c = *n
+	n.Subject.cloneInto(&c.Subject)
+	n.Using.cloneInto(&c.Using)
+}
+
+// Clone returns a deep copy of the TrimFunction
+func (n *TrimFunction) Clone() *TrimFunction {
+	if n == nil {
+		return nil
+	}
+	c := &TrimFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TrimFunction) cloneInto(c *TrimFunction) {
+	*c = *n
+	c.Character = n.Character.Clone()
+	n.Subject.cloneInto(&c.Subject)
+}
+
+// Clone returns a deep copy of the TruncateTableStatement
+func (n *TruncateTableStatement) Clone() *TruncateTableStatement {
+	if n == nil {
+		return nil
+	}
+	c := &TruncateTableStatement{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *TruncateTableStatement) cloneInto(c *TruncateTableStatement) {
+	*c = *n
+	c.SchemaName = clonePointer(n.SchemaName)
+}
+
+// Clone returns a deep copy of the UnionJoin
+func (n *UnionJoin) Clone() *UnionJoin {
+	if n == nil {
+		return nil
+	}
+	c := &UnionJoin{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *UnionJoin) cloneInto(c *UnionJoin) {
+	*c = *n
+	n.Left.cloneInto(&c.Left)
+	n.Right.cloneInto(&c.Right)
+}
+
+// Clone returns a deep copy of the UnsignedLiteral
+func (n *UnsignedLiteral) Clone() *UnsignedLiteral {
+	if n == nil {
+		return nil
+	}
+	c := &UnsignedLiteral{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *UnsignedLiteral) cloneInto(c *UnsignedLiteral) {
+	*c = *n
+	c.UnsignedNumeric = n.UnsignedNumeric.Clone()
+	c.General = n.General.Clone()
+}
+
+// Clone returns a deep copy of the UnsignedNumericLiteral
+func (n *UnsignedNumericLiteral) Clone() *UnsignedNumericLiteral {
+	if n == nil {
+		return nil
+	}
+	c := &UnsignedNumericLiteral{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *UnsignedNumericLiteral) cloneInto(c *UnsignedNumericLiteral) {
+	*c = *n
+	c.Value = cloneAny(n.Value)
+}
+
+// Clone returns a deep copy of the UnsignedValueSpecification
+func (n *UnsignedValueSpecification) Clone() *UnsignedValueSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &UnsignedValueSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *UnsignedValueSpecification) cloneInto(c *UnsignedValueSpecification) {
+	*c = *n
+	c.UnsignedLiteral = n.UnsignedLiteral.Clone()
+	c.GeneralValue = n.GeneralValue.Clone()
+}
+
+// Clone returns a deep copy of the UpdateStatementSearched
+func (n *UpdateStatementSearched) Clone() *UpdateStatementSearched {
+	if n == nil {
+		return nil
+	}
+	c := &UpdateStatementSearched{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *UpdateStatementSearched) cloneInto(c *UpdateStatementSearched) {
+	*c = *n
+	c.SchemaName = clonePointer(n.SchemaName)
+	c.Columns = slices.Clone(n.Columns)
+	if n.Values != nil {
+		c.Values = make([]interface{}, len(n.Values))
+		for x, e := range n.Values {
+			c.Values[x] = cloneAny(e)
+		}
+	}
+	if n.From != nil {
+		c.From = make([]TableReference, len(n.From))
+		for x := range n.From {
+			n.From[x].cloneInto(&c.From[x])
+		}
+	}
+	c.Where = n.Where.Clone()
+	c.OrderBy = n.OrderBy.Clone()
+	c.Limit = n.Limit.Clone()
+	c.Returning = n.Returning.Clone()
+}
+
+// Clone returns a deep copy of the ValueExpression
+func (n *ValueExpression) Clone() *ValueExpression {
+	if n == nil {
+		return nil
+	}
+	c := &ValueExpression{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ValueExpression) cloneInto(c *ValueExpression) {
+	*c = *n
+	c.Common = n.Common.Clone()
+	c.Boolean = n.Boolean.Clone()
+	c.Row = n.Row.Clone()
+}
+
+// Clone returns a deep copy of the ValueExpressionPrimary
+func (n *ValueExpressionPrimary) Clone() *ValueExpressionPrimary {
+	if n == nil {
+		return nil
+	}
+	c := &ValueExpressionPrimary{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ValueExpressionPrimary) cloneInto(c *ValueExpressionPrimary) {
+	*c = *n
+	c.Parenthesized = n.Parenthesized.Clone()
+	c.Primary = n.Primary.Clone()
+}
+
+// Clone returns a deep copy of the ValueSpecification
+func (n *ValueSpecification) Clone() *ValueSpecification {
+	if n == nil {
+		return nil
+	}
+	c := &ValueSpecification{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *ValueSpecification) cloneInto(c *ValueSpecification) {
+	*c = *n
+	c.Literal = n.Literal.Clone()
+	c.UnsignedValue = n.UnsignedValue.Clone()
+}
+
+// Clone returns a deep copy of the WhereClause
+func (n *WhereClause) Clone() *WhereClause {
+	if n == nil {
+		return nil
+	}
+	c := &WhereClause{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *WhereClause) cloneInto(c *WhereClause) {
+	*c = *n
+	n.Search.cloneInto(&c.Search)
+}
+
+// Clone returns a deep copy of the WidthBucketFunction
+func (n *WidthBucketFunction) Clone() *WidthBucketFunction {
+	if n == nil {
+		return nil
+	}
+	c := &WidthBucketFunction{}
+	n.cloneInto(c)
+	return c
+}
+
+func (n *WidthBucketFunction) cloneInto(c *WidthBucketFunction) {
+	*c = *n
+	n.Operand.cloneInto(&c.Operand)
+	n.Bound1.cloneInto(&c.Bound1)
+	n.Bound2.cloneInto(&c.Bound2)
+	n.Count.cloneInto(&c.Count)
+}
@@ -0,0 +1,165 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Code generated by nodegen. DO NOT EDIT.
+
+package grammar_test
+
+import "github.com/jaypipes/sqlb/core/grammar"
+
+// allNodes returns a zero value of every Node type in the grammar package
+func allNodes() []grammar.Node {
+	return []grammar.Node{
+		&grammar.AbsoluteValueExpression{},
+		&grammar.AddIntervalExpression{},
+		&grammar.AddSubtractDatetimeExpression{},
+		&grammar.AddSubtractExpression{},
+		&grammar.AddSubtractIntervalExpression{},
+		&grammar.AggregateFunction{},
+		&grammar.BetweenPredicate{},
+		&grammar.BinarySetFunction{},
+		&grammar.BlobFactor{},
+		&grammar.BlobPositionExpression{},
+		&grammar.BlobPrimary{},
+		&grammar.BlobValueExpression{},
+		&grammar.BlobValueFunction{},
+		&grammar.BooleanFactor{},
+		&grammar.BooleanPredicand{},
+		&grammar.BooleanPrimary{},
+		&grammar.BooleanTerm{},
+		&grammar.BooleanTest{},
+		&grammar.BooleanValueExpression{},
+		&grammar.CardinalityExpression{},
+		&grammar.CeilingFunction{},
+		&grammar.CharacterFactor{},
+		&grammar.CharacterLengthExpression{},
+		&grammar.CharacterOverlayFunction{},
+		&grammar.CharacterPrimary{},
+		&grammar.CharacterSubstringFunction{},
+		&grammar.CharacterTransliterationFunction{},
+		&grammar.CharacterValueExpression{},
+		&grammar.CharacterValueFunction{},
+		&grammar.ColumnReference{},
+		&grammar.CommonValueExpression{},
+		&grammar.ComparisonPredicate{},
+		&grammar.Concatenation{},
+		&grammar.Correlation{},
+		&grammar.CrossJoin{},
+		&grammar.CurrentTimeFunction{},
+		&grammar.CurrentTimestampFunction{},
+		&grammar.CursorSpecification{},
+		&grammar.DatetimeFactor{},
+		&grammar.DatetimePrimary{},
+		&grammar.DatetimeTerm{},
+		&grammar.DatetimeValueExpression{},
+		&grammar.DatetimeValueFunction{},
+		&grammar.DeleteStatementSearched{},
+		&grammar.DerivedColumn{},
+		&grammar.DerivedTable{},
+		&grammar.EndField{},
+		&grammar.ExponentialFunction{},
+		&grammar.ExtractExpression{},
+		&grammar.ExtractField{},
+		&grammar.ExtractSource{},
+		&grammar.Factor{},
+		&grammar.FloorFunction{},
+		&grammar.FoldFunction{},
+		&grammar.FromClause{},
+		&grammar.GeneralLiteral{},
+		&grammar.GeneralSetFunction{},
+		&grammar.GeneralValueSpecification{},
+		&grammar.GroupByClause{},
+		&grammar.GroupingColumnReference{},
+		&grammar.GroupingElement{},
+		&grammar.HavingClause{},
+		&grammar.HostParameterSpecification{},
+		&grammar.IdentifierChain{},
+		&grammar.InPredicate{},
+		&grammar.InsertStatement{},
+		&grammar.IntersectQuery{},
+		&grammar.IntervalFactor{},
+		&grammar.IntervalPrimary{},
+		&grammar.IntervalQualifier{},
+		&grammar.IntervalTerm{},
+		&grammar.IntervalValueExpression{},
+		&grammar.IntervalValueFunction{},
+		&grammar.JoinedTable{},
+		&grammar.LengthExpression{},
+		&grammar.LimitClause{},
+		&grammar.Literal{},
+		&grammar.LocalTimeFunction{},
+		&grammar.LocalTimestampFunction{},
+		&grammar.ModulusExpression{},
+		&grammar.MultiplyDivideExpression{},
+		&grammar.MultiplyDivideIntervalTerm{},
+		&grammar.MultiplyNumericIntervalFactor{},
+		&grammar.NaturalJoin{},
+		&grammar.NaturalLogarithm{},
+		&grammar.NonJoinQueryExpression{},
+		&grammar.NonJoinQueryPrimary{},
+		&grammar.NonJoinQueryTerm{},
+		&grammar.NonParenthesizedValueExpressionPrimary{},
+		&grammar.NormalizeFunction{},
+		&grammar.NullPredicate{},
+		&grammar.NumericPrimary{},
+		&grammar.NumericValueExpression{},
+		&grammar.NumericValueFunction{},
+		&grammar.OctetLengthExpression{},
+		&grammar.OrderByClause{},
+		&grammar.OrderedSetFunction{},
+		&grammar.OrdinaryGroupingSet{},
+		&grammar.PositionExpression{},
+		&grammar.PowerFunction{},
+		&grammar.Predicate{},
+		&grammar.PrimaryDatetimeField{},
+		&grammar.QualifiedJoin{},
+		&grammar.QueryExpression{},
+		&grammar.QueryExpressionBody{},
+		&grammar.QueryPrimary{},
+		&grammar.QuerySpecification{},
+		&grammar.QueryTerm{},
+		&grammar.RegexSubstringFunction{},
+		&grammar.ReturningClause{},
+		&grammar.RowValueExpression{},
+		&grammar.RowValuePredicand{},
+		&grammar.SchemaQualifiedName{},
+		&grammar.SecondPrimaryDatetimeField{},
+		&grammar.SelectList{},
+		&grammar.SelectSublist{},
+		&grammar.SetFunctionSpecification{},
+		&grammar.SignedNumericLiteral{},
+		&grammar.SimpleTable{},
+		&grammar.SingleDatetimeField{},
+		&grammar.SortSpecification{},
+		&grammar.SpecificTypeFunction{},
+		&grammar.SquareRoot{},
+		&grammar.StartEndDatetimeField{},
+		&grammar.StartField{},
+		&grammar.StringPositionExpression{},
+		&grammar.StringValueExpression{},
+		&grammar.StringValueFunction{},
+		&grammar.Subquery{},
+		&grammar.SubtractDatetimeExpression{},
+		&grammar.TableExpression{},
+		&grammar.TablePrimary{},
+		&grammar.TableReference{},
+		&grammar.Term{},
+		&grammar.TimezoneSpecifier{},
+		&grammar.TranscodingFunction{},
+		&grammar.TrimFunction{},
+		&grammar.TruncateTableStatement{},
+		&grammar.UnionJoin{},
+		&grammar.UnsignedLiteral{},
+		&grammar.UnsignedNumericLiteral{},
+		&grammar.UnsignedValueSpecification{},
+		&grammar.UpdateStatementSearched{},
+		&grammar.ValueExpression{},
+		&grammar.ValueExpressionPrimary{},
+		&grammar.ValueSpecification{},
+		&grammar.WhereClause{},
+		&grammar.WidthBucketFunction{},
+	}
+}
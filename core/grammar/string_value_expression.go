@@ -38,12 +38,28 @@ func (e *StringValueExpression) ArgCount(count *int) {
 }
 
 type CharacterValueExpression struct {
-	// Concatenation *Concatenation
-	Factor *CharacterFactor
+	Concatenation *Concatenation
+	Factor        *CharacterFactor
 }
 
 func (e *CharacterValueExpression) ArgCount(count *int) {
-	e.Factor.ArgCount(count)
+	if e.Concatenation != nil {
+		e.Concatenation.ArgCount(count)
+	} else if e.Factor != nil {
+		e.Factor.ArgCount(count)
+	}
+}
+
+// Concatenation represents the <concatenation> SQL grammar element. The
+// concatenation operator is "||" in standard SQL, PostgreSQL and SQLite.
+type Concatenation struct {
+	Left  CharacterValueExpression
+	Right CharacterFactor
+}
+
+func (c *Concatenation) ArgCount(count *int) {
+	c.Left.ArgCount(count)
+	c.Right.ArgCount(count)
 }
 
 type CharacterFactor struct {
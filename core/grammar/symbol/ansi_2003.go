@@ -35,6 +35,7 @@ const (
 	SymbolVerticalBar
 	SymbolLeftBrace
 	SymbolRightBrace
+	SymbolConcatenationOperator
 	SymbolANSI2003SpecialCharacterEnd = 100
 )
 
@@ -65,6 +66,8 @@ const (
 	VerticalBar         = "|"
 	LeftBrace           = "{"
 	RightBrace          = "}"
+	// ConcatenationOperator is the <concatenation operator>
+	ConcatenationOperator = "||"
 )
 
 // Reserved words in lexicographical order
@@ -1076,3 +1079,58 @@ const (
 	Write                     = "WRITE"
 	Zone                      = "ZONE"
 )
+
+// ansi2003Reserved contains the reserved words of ANSI SQL:2003. These
+// cannot be used as identifiers unless they are delimited.
+var ansi2003Reserved = map[string]struct{}{
+	Add: {}, All: {}, Allocation: {}, Alter: {}, And: {}, Any: {}, Are: {},
+	Array: {}, As: {}, Asensitive: {}, Asymmetric: {}, At: {}, Atomic: {},
+	Authorization: {}, Begin: {}, Between: {}, Bigint: {}, Binary: {}, Blob: {},
+	Boolean: {}, Both: {}, By: {}, Call: {}, Called: {}, Cascaded: {}, Case: {},
+	Cast: {}, Char: {}, Character: {}, Check: {}, Clob: {}, Close: {},
+	Collate: {}, Column: {}, Commit: {}, Connect: {}, Constraint: {},
+	Continue: {}, Corresponding: {}, Create: {}, Cross: {}, Cube: {},
+	Current: {}, CurrentDate: {}, CurrentDefaultTransformGroup: {},
+	CurrentPath: {}, CurrentRole: {}, CurrentTime: {}, CurrentTimestamp: {},
+	CurrentTransformGroupForType: {}, CurrentUser: {}, Cursor: {}, Cycle: {},
+	Date: {}, Day: {}, Deallocate: {}, Dec: {}, Decimal: {}, Declare: {},
+	Default: {}, Delete: {}, Deref: {}, Describe: {}, Deterministic: {},
+	Disconnect: {}, Distinct: {}, Double: {}, Drop: {}, Dynamic: {}, Each: {},
+	Element: {}, Else: {}, End: {}, EndExec: {}, Escape: {}, Except: {},
+	Exec: {}, Execute: {}, Exists: {}, External: {}, False: {}, Fetch: {},
+	Filter: {}, Float: {}, For: {}, Foreign: {}, Free: {}, From: {}, Full: {},
+	Function: {}, Get: {}, Global: {}, Grant: {}, Group: {}, Grouping: {},
+	Having: {}, Hold: {}, Hour: {}, Identity: {}, Immediate: {}, In: {},
+	Indicator: {}, Inner: {}, InOut: {}, Input: {}, Insensitive: {}, Insert: {},
+	Int: {}, Integer: {}, Intersect: {}, Interval: {}, Into: {}, Is: {},
+	Isolation: {}, Join: {}, Language: {}, Large: {}, Lateral: {}, Leading: {},
+	Left: {}, Like: {}, Local: {}, LocalTime: {}, LocalTimestamp: {}, Match: {},
+	Member: {}, Merge: {}, Method: {}, Minute: {}, Modifies: {}, Module: {},
+	Month: {}, MultiSet: {}, National: {}, Natural: {}, NChar: {}, NClob: {},
+	New: {}, No: {}, None: {}, Not: {}, Null: {}, Numeric: {}, Of: {}, Old: {},
+	On: {}, Only: {}, Open: {}, Or: {}, Order: {}, Out: {}, Outer: {},
+	Output: {}, Over: {}, Overlaps: {}, Parameter: {}, Partition: {},
+	Precision: {}, Prepare: {}, Primary: {}, Procedure: {}, Range: {},
+	Reads: {}, Real: {}, Recursive: {}, Ref: {}, References: {},
+	Referencing: {}, RegrAvgX: {}, RegrAvgY: {}, RegrCount: {},
+	RegrIntercept: {}, RegrR2: {}, RegrSlope: {}, RegrSXX: {}, RegrSXY: {},
+	RegrSYY: {}, Release: {}, Result: {}, Return: {}, Returns: {}, Revoke: {},
+	Right: {}, Rollback: {}, Rollup: {}, Row: {}, Rows: {}, Savepoint: {},
+	Scroll: {}, Search: {}, Second: {}, Select: {}, Sensitive: {},
+	SessionUser: {}, Set: {}, Similar: {}, Smallint: {}, Some: {}, Specific: {},
+	SpecificType: {}, SQL: {}, SQLException: {}, SQLState: {}, SQLWarning: {},
+	Start: {}, Static: {}, SubMultiSet: {}, Symmetric: {}, System: {},
+	SystemUser: {}, Table: {}, Then: {}, Time: {}, Timestamp: {},
+	TimezoneHour: {}, TimezoneMinute: {}, To: {}, Trailing: {}, Translation: {},
+	Treat: {}, Trigger: {}, True: {}, UEscape: {}, Union: {}, Unique: {},
+	Unknown: {}, Unnest: {}, Update: {}, Upper: {}, User: {}, Using: {},
+	Value: {}, Values: {}, VarPop: {}, VarSamp: {}, Varchar: {}, Varying: {},
+	When: {}, Whenever: {}, Where: {}, WidthBucket: {}, Window: {}, With: {},
+	Within: {}, Without: {}, Year: {},
+}
+
+// IsANSI2003Reserved returns true if the supplied word is a reserved word in
+// ANSI SQL:2003. The comparison is case-insensitive.
+func IsANSI2003Reserved(word string) bool {
+	return isReserved(ansi2003Reserved, word)
+}
@@ -21,9 +21,47 @@ const (
 const (
 	SymbolPostgreSQLReservedStart Symbol = SymbolPostgreSQLSpecialCharacterEnd + 1
 	SymbolLimit
+	SymbolReturning
+	SymbolTruncate
 )
 
 const (
-	Limit  = "LIMIT"
-	Offset = "OFFSET"
+	Limit     = "LIMIT"
+	Offset    = "OFFSET"
+	Returning = "RETURNING"
+	Truncate  = "TRUNCATE"
+	Ctid      = "ctid"
 )
+
+// postgreSQLReserved contains the reserved key words of PostgreSQL. These
+// cannot be used as identifiers unless they are delimited.
+var postgreSQLReserved = map[string]struct{}{
+	"ALL": {}, "ANALYSE": {}, "ANALYZE": {}, "AND": {}, "ANY": {}, "ARRAY": {},
+	"AS": {}, "ASC": {}, "ASYMMETRIC": {}, "AUTHORIZATION": {}, "BINARY": {},
+	"BOTH": {}, "CASE": {}, "CAST": {}, "CHECK": {}, "COLLATE": {},
+	"COLLATION": {}, "COLUMN": {}, "CONCURRENTLY": {}, "CONSTRAINT": {},
+	"CREATE": {}, "CROSS": {}, "CURRENT_CATALOG": {}, "CURRENT_DATE": {},
+	"CURRENT_ROLE": {}, "CURRENT_SCHEMA": {}, "CURRENT_TIME": {},
+	"CURRENT_TIMESTAMP": {}, "CURRENT_USER": {}, "DEFAULT": {},
+	"DEFERRABLE": {}, "DESC": {}, "DISTINCT": {}, "DO": {}, "ELSE": {},
+	"END": {}, "EXCEPT": {}, "FALSE": {}, "FETCH": {}, "FOR": {}, "FOREIGN": {},
+	"FREEZE": {}, "FROM": {}, "FULL": {}, "GRANT": {}, "GROUP": {},
+	"HAVING": {}, "ILIKE": {}, "IN": {}, "INITIALLY": {}, "INNER": {},
+	"INTERSECT": {}, "INTO": {}, "IS": {}, "ISNULL": {}, "JOIN": {},
+	"LATERAL": {}, "LEADING": {}, "LEFT": {}, "LIKE": {}, "LIMIT": {},
+	"LOCALTIME": {}, "LOCALTIMESTAMP": {}, "NATURAL": {}, "NOT": {},
+	"NOTNULL": {}, "NULL": {}, "OFFSET": {}, "ON": {}, "ONLY": {}, "OR": {},
+	"ORDER": {}, "OUTER": {}, "OVERLAPS": {}, "PLACING": {}, "PRIMARY": {},
+	"REFERENCES": {}, "RETURNING": {}, "RIGHT": {}, "SELECT": {},
+	"SESSION_USER": {}, "SIMILAR": {}, "SOME": {}, "SYMMETRIC": {},
+	"SYSTEM_USER": {}, "TABLE": {}, "TABLESAMPLE": {}, "THEN": {}, "TO": {},
+	"TRAILING": {}, "TRUE": {}, "UNION": {}, "UNIQUE": {}, "USER": {},
+	"USING": {}, "VARIADIC": {}, "VERBOSE": {}, "WHEN": {}, "WHERE": {},
+	"WINDOW": {}, "WITH": {},
+}
+
+// IsPostgreSQLReserved returns true if the supplied word is a reserved word in
+// PostgreSQL. The comparison is case-insensitive.
+func IsPostgreSQLReserved(word string) bool {
+	return isReserved(postgreSQLReserved, word)
+}
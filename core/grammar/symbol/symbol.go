@@ -7,3 +7,29 @@
 package symbol
 
 type Symbol int
+
+// maxReservedLen is the length of the longest reserved word of any dialect
+const maxReservedLen = 32
+
+// isReserved returns true if the supplied word, compared case-insensitively,
+// is in the supplied set of upper case reserved words. Unlike
+// strings.ToUpper, it does not allocate.
+func isReserved(words map[string]struct{}, word string) bool {
+	if len(word) > maxReservedLen {
+		return false
+	}
+	var buf [maxReservedLen]byte
+	for x := 0; x < len(word); x++ {
+		c := word[x]
+		if c >= 0x80 {
+			// Reserved words are ASCII
+			return false
+		}
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		buf[x] = c
+	}
+	_, ok := words[string(buf[:len(word)])]
+	return ok
+}
@@ -0,0 +1,82 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package symbol
+
+// Special character symbols for T-SQL variants
+const (
+	SymbolTSQLSpecialCharacterStart Symbol = 50000
+	SymbolAtSign
+	SymbolTSQLSpecialCharacterEnd = 50200
+)
+
+const (
+	AtSign = "@"
+)
+
+// Reserved words for T-SQL variants in lexicographical order
+const (
+	SymbolTSQLReservedStart Symbol = SymbolTSQLSpecialCharacterEnd + 1
+	SymbolDeleted
+	SymbolInserted
+	SymbolTop
+)
+
+const (
+	Deleted  = "DELETED"
+	Inserted = "INSERTED"
+	Top      = "TOP"
+)
+
+// tsqlReserved contains the reserved keywords of T-SQL. These cannot be used
+// as identifiers unless they are delimited.
+var tsqlReserved = map[string]struct{}{
+	"ADD": {}, "ALL": {}, "ALTER": {}, "AND": {}, "ANY": {}, "AS": {},
+	"ASC": {}, "AUTHORIZATION": {}, "BACKUP": {}, "BEGIN": {}, "BETWEEN": {},
+	"BREAK": {}, "BROWSE": {}, "BULK": {}, "BY": {}, "CASCADE": {}, "CASE": {},
+	"CHECK": {}, "CHECKPOINT": {}, "CLOSE": {}, "CLUSTERED": {}, "COALESCE": {},
+	"COLLATE": {}, "COLUMN": {}, "COMMIT": {}, "COMPUTE": {}, "CONSTRAINT": {},
+	"CONTAINS": {}, "CONTAINSTABLE": {}, "CONTINUE": {}, "CONVERT": {},
+	"CREATE": {}, "CROSS": {}, "CURRENT": {}, "CURRENT_DATE": {},
+	"CURRENT_TIME": {}, "CURRENT_TIMESTAMP": {}, "CURRENT_USER": {},
+	"CURSOR": {}, "DATABASE": {}, "DBCC": {}, "DEALLOCATE": {}, "DECLARE": {},
+	"DEFAULT": {}, "DELETE": {}, "DENY": {}, "DESC": {}, "DISK": {},
+	"DISTINCT": {}, "DISTRIBUTED": {}, "DOUBLE": {}, "DROP": {}, "DUMP": {},
+	"ELSE": {}, "END": {}, "ERRLVL": {}, "ESCAPE": {}, "EXCEPT": {}, "EXEC": {},
+	"EXECUTE": {}, "EXISTS": {}, "EXIT": {}, "EXTERNAL": {}, "FETCH": {},
+	"FILE": {}, "FILLFACTOR": {}, "FOR": {}, "FOREIGN": {}, "FREETEXT": {},
+	"FREETEXTTABLE": {}, "FROM": {}, "FULL": {}, "FUNCTION": {}, "GOTO": {},
+	"GRANT": {}, "GROUP": {}, "HAVING": {}, "HOLDLOCK": {}, "IDENTITY": {},
+	"IDENTITYCOL": {}, "IDENTITY_INSERT": {}, "IF": {}, "IN": {}, "INDEX": {},
+	"INNER": {}, "INSERT": {}, "INTERSECT": {}, "INTO": {}, "IS": {},
+	"JOIN": {}, "KEY": {}, "KILL": {}, "LEFT": {}, "LIKE": {}, "LINENO": {},
+	"LOAD": {}, "MERGE": {}, "NATIONAL": {}, "NOCHECK": {}, "NONCLUSTERED": {},
+	"NOT": {}, "NULL": {}, "NULLIF": {}, "OF": {}, "OFF": {}, "OFFSETS": {},
+	"ON": {}, "OPEN": {}, "OPENDATASOURCE": {}, "OPENQUERY": {},
+	"OPENROWSET": {}, "OPENXML": {}, "OPTION": {}, "OR": {}, "ORDER": {},
+	"OUTER": {}, "OVER": {}, "PERCENT": {}, "PIVOT": {}, "PLAN": {},
+	"PRECISION": {}, "PRIMARY": {}, "PRINT": {}, "PROC": {}, "PROCEDURE": {},
+	"PUBLIC": {}, "RAISERROR": {}, "READ": {}, "READTEXT": {},
+	"RECONFIGURE": {}, "REFERENCES": {}, "REPLICATION": {}, "RESTORE": {},
+	"RESTRICT": {}, "RETURN": {}, "REVERT": {}, "REVOKE": {}, "RIGHT": {},
+	"ROLLBACK": {}, "ROWCOUNT": {}, "ROWGUIDCOL": {}, "RULE": {}, "SAVE": {},
+	"SCHEMA": {}, "SECURITYAUDIT": {}, "SELECT": {},
+	"SEMANTICKEYPHRASETABLE": {}, "SEMANTICSIMILARITYDETAILSTABLE": {},
+	"SEMANTICSIMILARITYTABLE": {}, "SESSION_USER": {}, "SET": {}, "SETUSER": {},
+	"SHUTDOWN": {}, "SOME": {}, "STATISTICS": {}, "SYSTEM_USER": {},
+	"TABLE": {}, "TABLESAMPLE": {}, "TEXTSIZE": {}, "THEN": {}, "TO": {},
+	"TOP": {}, "TRAN": {}, "TRANSACTION": {}, "TRIGGER": {}, "TRUNCATE": {},
+	"TRY_CONVERT": {}, "TSEQUAL": {}, "UNION": {}, "UNIQUE": {}, "UNPIVOT": {},
+	"UPDATE": {}, "UPDATETEXT": {}, "USE": {}, "USER": {}, "VALUES": {},
+	"VARYING": {}, "VIEW": {}, "WAITFOR": {}, "WHEN": {}, "WHERE": {},
+	"WHILE": {}, "WITH": {}, "WITHIN": {}, "WRITETEXT": {},
+}
+
+// IsTSQLReserved returns true if the supplied word is a reserved keyword in
+// T-SQL. The comparison is case-insensitive.
+func IsTSQLReserved(word string) bool {
+	return isReserved(tsqlReserved, word)
+}
@@ -33,6 +33,9 @@ package grammar
 
 // TablePrimary represents the <table primary> SQL grammar element
 type TablePrimary struct {
+	// SchemaName is the optional name of the schema containing the table
+	// named by TableName
+	SchemaName   *string
 	TableName    *string
 	QueryName    *string
 	DerivedTable *DerivedTable
@@ -0,0 +1,27 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package grammar
+
+// <truncate table statement>    ::=   TRUNCATE TABLE <target table> [ <identity column restart option> ]
+//
+// <identity column restart option>    ::=   CONTINUE IDENTITY | RESTART IDENTITY
+
+// TruncateTableStatement represents a TRUNCATE TABLE SQL statement
+type TruncateTableStatement struct {
+	// SchemaName is the optional name of the schema containing the table
+	// named by TableName
+	SchemaName *string
+	TableName  string
+	// RestartIdentity indicates that identity/sequence columns owned by the
+	// table should be reset.
+	RestartIdentity bool
+	// Cascade is a non-standard extension that indicates that tables having
+	// foreign key references to the table should also be truncated.
+	Cascade bool
+}
+
+func (s *TruncateTableStatement) ArgCount(count *int) {}
@@ -10,15 +10,41 @@ package grammar
 
 // UpdateStatementSearched represents an UPDATE SQL statement
 type UpdateStatementSearched struct {
-	TableName string
-	Columns   []string
-	Values    []interface{}
-	Where     *WhereClause
+	// SchemaName is the optional name of the schema containing the table
+	// named by TableName
+	SchemaName *string
+	TableName  string
+	Columns    []string
+	Values     []interface{}
+	// From is a non-standard extension containing the table references that
+	// the target table is joined with in a multi-table UPDATE. When non-empty,
+	// it includes the target table itself. It is output as UPDATE ... FROM
+	// for PostgreSQL and SQLite and as UPDATE ... JOIN for MySQL.
+	From  []TableReference
+	Where *WhereClause
+	// OrderBy is a non-standard extension that orders the rows to be
+	// updated. It is only meaningful when combined with Limit.
+	OrderBy *OrderByClause
+	// Limit is a non-standard extension that limits the number of rows
+	// updated.
+	Limit *LimitClause
+	// Returning is a non-standard extension containing the columns of the
+	// updated rows to return.
+	Returning *ReturningClause
 }
 
 func (s *UpdateStatementSearched) ArgCount(count *int) {
 	*count += len(s.Values)
+	for _, tref := range s.From {
+		tref.ArgCount(count)
+	}
 	if s.Where != nil {
 		s.Where.ArgCount(count)
 	}
+	if s.OrderBy != nil {
+		s.OrderBy.ArgCount(count)
+	}
+	if s.Limit != nil {
+		s.Limit.ArgCount(count)
+	}
 }
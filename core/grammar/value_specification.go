@@ -84,13 +84,34 @@ type UnsignedValueSpecification struct {
 func (s *UnsignedValueSpecification) ArgCount(count *int) {
 	if s.UnsignedLiteral != nil {
 		s.UnsignedLiteral.ArgCount(count)
+	} else if s.GeneralValue != nil {
+		s.GeneralValue.ArgCount(count)
 	}
 }
 
 type GeneralValueSpecification struct {
-	//HostParameterSpecification *HostParameterSpecification
+	HostParameter *HostParameterSpecification
 	//SQLParameterReference *SQLParameterReference
 	//DynamicParameterSpecification *DynamicParameterSpecification
 	//EmbeddedVariableSpecification *EmbeddedVariableSpecification
 	//CurrentCollationSpecification *CurrentCollationSpecification
 }
+
+func (s *GeneralValueSpecification) ArgCount(count *int) {
+	if s.HostParameter != nil {
+		s.HostParameter.ArgCount(count)
+	}
+}
+
+// HostParameterSpecification is a named parameter whose value is supplied
+// when the SQL statement is executed. Since database drivers only accept
+// positional query args, it is output as the Dialect's placeholder, e.g. ? or
+// $1, instead of as a <host parameter name>, and its Name is used to match
+// the parameter to its value.
+type HostParameterSpecification struct {
+	Name string
+}
+
+func (s *HostParameterSpecification) ArgCount(count *int) {
+	*count++
+}
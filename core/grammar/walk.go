@@ -0,0 +1,148 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package grammar
+
+import "fmt"
+
+//go:generate go run gen.go
+
+// Node is implemented by a pointer to every struct in the grammar package
+type Node interface {
+	node()
+}
+
+// Visitor has its Visit method called by Walk for each Node encountered. If
+// the Visitor w returned by Visit is not nil, Walk visits each child of the
+// Node with w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses the tree of Nodes rooted at the supplied Node in depth-first
+// order. It starts by calling v.Visit(node). If the Visitor w returned by
+// v.Visit(node) is not nil, Walk is called recursively with w for each of the
+// non-nil children of the Node, followed by a call of w.Visit(nil).
+//
+// The children of a Node are the Nodes in the fields of its struct, in field
+// order. A child stored in a struct field by value, rather than as a pointer,
+// is visited as a pointer to the field, so that a Visitor may modify it in
+// place. Values of interface fields, like the Value of a GeneralLiteral or the
+// Values of an InsertStatement, are visited if they are Nodes.
+func Walk(node Node, v Visitor) {
+	if node == nil {
+		return
+	}
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	walkChildren(node, v)
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses the tree of Nodes rooted at the supplied Node in
+// depth-first order, calling f(node) for each Node. If f returns true,
+// Inspect calls f for each of the non-nil children of the Node, followed by
+// a call of f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(node, inspector(f))
+}
+
+// Rewrite replaces each Node in the tree of Nodes rooted at the supplied Node
+// with the Node returned by fn and returns the replacement for the root Node.
+//
+// The tree is rewritten bottom-up: fn is called for each Node after the
+// Node's children have been rewritten. fn may return the Node it is called
+// with, possibly after modifying it, a different Node of the same type, or
+// nil, which removes an optional child, e.g. a WHERE clause, or resets a
+// child stored by value to its zero value. fn must not return a Node of a
+// different type for a child that is stored in a field of a struct type, and
+// Rewrite panics if it does. Values of interface fields, like the Value of a
+// GeneralLiteral, may be replaced with a Node of any type.
+//
+// The tree is rewritten in place, so rewrite a Clone of the tree to leave the
+// original unchanged.
+func Rewrite(node Node, fn func(Node) Node) Node {
+	if node == nil {
+		return nil
+	}
+	return rewrite(node, fn)
+}
+
+// rewrite rewrites the children of the supplied Node and then the Node
+// itself
+func rewrite(node Node, fn func(Node) Node) Node {
+	rewriteChildren(node, fn)
+	return fn(node)
+}
+
+// rewritePointer returns the replacement for a child stored in a pointer
+// field
+func rewritePointer[P Node](node P, fn func(Node) Node) P {
+	var zero P
+	r := rewrite(node, fn)
+	if r == nil {
+		return zero
+	}
+	p, ok := r.(P)
+	if !ok {
+		panic(fmt.Sprintf("grammar: cannot rewrite %T as %T", node, r))
+	}
+	return p
+}
+
+// rewriteValue rewrites a child stored in a struct field by value, copying
+// the replacement into the field
+func rewriteValue[T any, P interface {
+	*T
+	Node
+}](node P, fn func(Node) Node) {
+	var zero T
+	r := rewrite(node, fn)
+	if r == nil {
+		*node = zero
+		return
+	}
+	p, ok := r.(P)
+	if !ok {
+		panic(fmt.Sprintf("grammar: cannot rewrite %T as %T", node, r))
+	}
+	switch {
+	case p == nil:
+		*node = zero
+	case p != node:
+		*node = *p
+	}
+}
+
+// walkAny walks the value of an interface field if it is a Node
+func walkAny(val interface{}, v Visitor) {
+	if n, ok := val.(Node); ok {
+		Walk(n, v)
+	}
+}
+
+// rewriteAny returns the replacement for the value of an interface field if
+// it is a Node, or the value unchanged otherwise
+func rewriteAny(val interface{}, fn func(Node) Node) interface{} {
+	n, ok := val.(Node)
+	if !ok {
+		return val
+	}
+	if r := rewrite(n, fn); r != nil {
+		return r
+	}
+	return nil
+}
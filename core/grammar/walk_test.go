@@ -0,0 +1,319 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package grammar_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/grammar/internal/nodegen"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var grammarPkgPath = reflect.TypeOf(grammar.Subquery{}).PkgPath()
+
+// isNodeType returns true if the supplied type is a struct of the grammar
+// package
+func isNodeType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == grammarPkgPath
+}
+
+// populate sets every field of the supplied struct value that can hold a
+// Node to a Node, populating those Nodes in turn until the supplied depth is
+// reached. Interface fields are set to a HostParameterSpecification. Other
+// pointer and slice fields are set to a pointer to, or a slice of, a zero
+// value.
+func populate(v reflect.Value, depth int) {
+	for x := 0; x < v.NumField(); x++ {
+		f := v.Field(x)
+		switch f.Kind() {
+		case reflect.Struct:
+			if isNodeType(f.Type()) {
+				populate(f, depth-1)
+			}
+		case reflect.Pointer:
+			if depth <= 0 {
+				continue
+			}
+			f.Set(reflect.New(f.Type().Elem()))
+			if isNodeType(f.Type().Elem()) {
+				populate(f.Elem(), depth-1)
+			}
+		case reflect.Slice:
+			et := f.Type().Elem()
+			if depth <= 0 {
+				continue
+			}
+			switch {
+			case isNodeType(et):
+				f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+				populate(f.Index(0), depth-1)
+			case et.Kind() == reflect.Pointer && isNodeType(et.Elem()):
+				f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+				f.Index(0).Set(reflect.New(et.Elem()))
+				populate(f.Index(0).Elem(), depth-1)
+			case et.Kind() == reflect.Interface:
+				f.Set(reflect.ValueOf([]interface{}{
+					&grammar.HostParameterSpecification{Name: "p"},
+				}))
+			default:
+				f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+			}
+		case reflect.Interface:
+			if depth > 0 {
+				f.Set(reflect.ValueOf(
+					&grammar.HostParameterSpecification{Name: "p"},
+				))
+			}
+		}
+	}
+}
+
+// collect adds the supplied struct value, and every Node reachable from its
+// fields, to the supplied set of Nodes, without using the grammar package's
+// walking code
+func collect(v reflect.Value, nodes map[grammar.Node]bool) {
+	nodes[v.Addr().Interface().(grammar.Node)] = true
+	for x := 0; x < v.NumField(); x++ {
+		f := v.Field(x)
+		switch f.Kind() {
+		case reflect.Struct:
+			if isNodeType(f.Type()) {
+				collect(f, nodes)
+			}
+		case reflect.Pointer:
+			if !f.IsNil() && isNodeType(f.Type().Elem()) {
+				collect(f.Elem(), nodes)
+			}
+		case reflect.Slice:
+			for y := 0; y < f.Len(); y++ {
+				e := f.Index(y)
+				if e.Kind() == reflect.Interface || e.Kind() == reflect.Pointer {
+					if e.IsNil() {
+						continue
+					}
+					e = e.Elem()
+					if e.Kind() == reflect.Pointer {
+						e = e.Elem()
+					}
+				}
+				if isNodeType(e.Type()) {
+					collect(e, nodes)
+				}
+			}
+		case reflect.Interface:
+			if !f.IsNil() && f.Elem().Kind() == reflect.Pointer &&
+				isNodeType(f.Elem().Type().Elem()) {
+				collect(f.Elem().Elem(), nodes)
+			}
+		}
+	}
+}
+
+// recorder is a Visitor that records the Nodes it visits
+type recorder struct {
+	visited []grammar.Node
+	nils    int
+}
+
+func (r *recorder) Visit(node grammar.Node) grammar.Visitor {
+	if node == nil {
+		r.nils++
+	} else {
+		r.visited = append(r.visited, node)
+	}
+	return r
+}
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	files, err := nodegen.Generate(".")
+	require.Nil(t, err)
+	for name, want := range files {
+		got, err := os.ReadFile(name)
+		require.Nil(t, err)
+		assert.Equal(
+			t, string(want), string(got),
+			"%s is out of date. Run `go generate ./core/grammar`.", name,
+		)
+	}
+}
+
+func TestWalkAndRewriteEveryNode(t *testing.T) {
+	for _, n := range allNodes() {
+		typ := reflect.TypeOf(n).Elem()
+		t.Run(typ.Name(), func(t *testing.T) {
+			assert := assert.New(t)
+			populate(reflect.ValueOf(n).Elem(), 2)
+			want := map[grammar.Node]bool{}
+			collect(reflect.ValueOf(n).Elem(), want)
+
+			r := &recorder{}
+			grammar.Walk(n, r)
+			got := map[grammar.Node]bool{}
+			for _, v := range r.visited {
+				got[v] = true
+			}
+			assert.Equal(want, got)
+			assert.Len(r.visited, len(want), "nodes visited more than once")
+			assert.Equal(len(want), r.nils)
+
+			rewritten := map[grammar.Node]bool{}
+			root := grammar.Rewrite(n, func(node grammar.Node) grammar.Node {
+				rewritten[node] = true
+				return node
+			})
+			assert.Equal(n, root)
+			assert.Equal(want, rewritten)
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+
+	sel := expr.Select(users.C("name"), articles.C("id")).
+		Join(articles, expr.Equal(users.C("id"), articles.C("author"))).
+		Where(expr.Equal(users.C("name"), "foo"))
+
+	// Collect the names of the tables the query refers to
+	tables := []string{}
+	grammar.Inspect(sel.QuerySpecification(), func(node grammar.Node) bool {
+		if tp, ok := node.(*grammar.TablePrimary); ok && tp.TableName != nil {
+			tables = append(tables, *tp.TableName)
+		}
+		return true
+	})
+	assert.Equal(t, []string{"users", "articles"}, tables)
+
+	// Returning false skips the children of a Node
+	visited := 0
+	grammar.Inspect(sel.QuerySpecification(), func(node grammar.Node) bool {
+		if node != nil {
+			visited++
+		}
+		_, isWhere := node.(*grammar.WhereClause)
+		return !isWhere
+	})
+	all := 0
+	grammar.Inspect(sel.QuerySpecification(), func(node grammar.Node) bool {
+		if node != nil {
+			all++
+		}
+		return true
+	})
+	assert.Less(t, visited, all)
+}
+
+func TestRewrite(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	opts := []types.Option{types.WithDialect(types.DialectPostgreSQL)}
+
+	t.Run("add tenant filter", func(t *testing.T) {
+		sel := expr.Select(users.C("name")).
+			Where(expr.Equal(users.C("id"), 1))
+		subq := expr.Select(articles.C("author")).
+			Where(expr.Equal(articles.C("state"), 2))
+		outer := expr.Select(users.C("name")).
+			Where(expr.In(users.C("id"), subq.QuerySpecification()))
+
+		tenant := expr.Equal(users.C("name"), "acme")
+		addTenant := func(node grammar.Node) grammar.Node {
+			qs, ok := node.(*grammar.QuerySpecification)
+			if !ok || len(qs.TableExpression.From.TableReferences) == 0 {
+				return node
+			}
+			ref := qs.TableExpression.From.TableReferences[0]
+			if ref.Primary == nil || ref.Primary.TableName == nil ||
+				*ref.Primary.TableName != "users" {
+				return node
+			}
+			// AND the tenant filter onto the query's single search term
+			where := qs.TableExpression.Where
+			where.Search = grammar.BooleanValueExpression{
+				Unary: &grammar.BooleanTerm{
+					AndLeft: where.Search.Unary,
+					AndRight: &grammar.BooleanFactor{
+						Test: grammar.BooleanTest{
+							Primary: grammar.BooleanPrimary{
+								Predicate: &grammar.Predicate{
+									Comparison: tenant,
+								},
+							},
+						},
+					},
+				},
+			}
+			return qs
+		}
+
+		grammar.Rewrite(sel.QuerySpecification(), addTenant)
+		qs, qargs := builder.New(opts...).StringArgs(sel.QuerySpecification())
+		assert.Equal(t, "SELECT users.name FROM users WHERE users.id = $1 AND users.name = $2", qs)
+		assert.Equal(t, []interface{}{1, "acme"}, qargs)
+
+		grammar.Rewrite(outer.QuerySpecification(), addTenant)
+		qs, _ = builder.New(opts...).StringArgs(outer.QuerySpecification())
+		assert.Equal(t, "SELECT users.name FROM users WHERE users.id IN((SELECT articles.author FROM articles WHERE articles.state = $1)) AND users.name = $2", qs)
+	})
+
+	t.Run("redact literals", func(t *testing.T) {
+		sel := expr.Select(users.C("name")).
+			Where(expr.And(
+				expr.Equal(users.C("name"), "secret"),
+				expr.Equal(users.C("id"), 42),
+			))
+		grammar.Rewrite(sel.QuerySpecification(), func(node grammar.Node) grammar.Node {
+			switch lit := node.(type) {
+			case *grammar.GeneralLiteral:
+				return &grammar.GeneralLiteral{Value: "<redacted>"}
+			case *grammar.UnsignedNumericLiteral:
+				return &grammar.UnsignedNumericLiteral{Value: 0}
+			default:
+				return lit
+			}
+		})
+		_, qargs := builder.New(opts...).StringArgs(sel.QuerySpecification())
+		assert.Equal(t, []interface{}{"<redacted>", 0}, qargs)
+	})
+
+	t.Run("remove optional child", func(t *testing.T) {
+		sel := expr.Select(users.C("name")).
+			Where(expr.Equal(users.C("id"), 1))
+		grammar.Rewrite(sel.QuerySpecification(), func(node grammar.Node) grammar.Node {
+			if _, ok := node.(*grammar.WhereClause); ok {
+				return nil
+			}
+			return node
+		})
+		qs, _ := builder.New(opts...).StringArgs(sel.QuerySpecification())
+		assert.Equal(t, "SELECT users.name FROM users", qs)
+	})
+
+	t.Run("replace child of a different type", func(t *testing.T) {
+		sel := expr.Select(users.C("name")).
+			Where(expr.Equal(users.C("id"), 1))
+		assert.Panics(t, func() {
+			grammar.Rewrite(sel.QuerySpecification(), func(node grammar.Node) grammar.Node {
+				if _, ok := node.(*grammar.WhereClause); ok {
+					return &grammar.HavingClause{}
+				}
+				return node
+			})
+		})
+	})
+}
@@ -11,15 +11,79 @@ import (
 	"github.com/jaypipes/sqlb/core/types"
 )
 
+// ColumnOption sets a property of the Column produced by NewColumn()
+type ColumnOption func(*Column)
+
+// WithDataType sets the Column's data type, e.g. "varchar" or "int"
+func WithDataType(dataType string) ColumnOption {
+	return func(c *Column) {
+		c.dataType = dataType
+	}
+}
+
+// WithLength sets the Column's maximum length in characters
+func WithLength(length int64) ColumnOption {
+	return func(c *Column) {
+		c.length = length
+	}
+}
+
+// WithPrecision sets the Column's numeric precision
+func WithPrecision(precision int64) ColumnOption {
+	return func(c *Column) {
+		c.precision = precision
+	}
+}
+
+// WithScale sets the Column's numeric scale
+func WithScale(scale int64) ColumnOption {
+	return func(c *Column) {
+		c.scale = scale
+	}
+}
+
+// WithNullable sets whether the Column accepts NULL values
+func WithNullable(nullable bool) ColumnOption {
+	return func(c *Column) {
+		c.nullable = nullable
+	}
+}
+
+// WithDefault sets the Column's default value expression
+func WithDefault(expr string) ColumnOption {
+	return func(c *Column) {
+		c.defaultExpr = &expr
+	}
+}
+
+// WithAutoIncrement marks the Column as an auto-increment or identity column
+func WithAutoIncrement() ColumnOption {
+	return func(c *Column) {
+		c.autoIncrement = true
+	}
+}
+
+// WithCollation sets the Column's collation
+func WithCollation(collation string) ColumnOption {
+	return func(c *Column) {
+		c.collation = collation
+	}
+}
+
 // NewColumn returns a new Column with the supplied properties
 func NewColumn(
 	t types.Relation,
 	name string,
+	opts ...ColumnOption,
 ) *Column {
-	return &Column{
+	c := &Column{
 		t:    t,
 		name: name,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // Column describes a column in a Table
@@ -31,6 +95,23 @@ type Column struct {
 	// alias is an optional alias for the column (when a user uses the As()
 	// method to alias a column in a SELECT statement)
 	alias string
+	// dataType is the name of the column's data type, e.g. "varchar"
+	dataType string
+	// length is the maximum length in characters of a character column
+	length int64
+	// precision is the precision of a numeric column
+	precision int64
+	// scale is the scale of a numeric column
+	scale int64
+	// nullable is true if the column accepts NULL values
+	nullable bool
+	// defaultExpr is the column's default value expression, if any
+	defaultExpr *string
+	// autoIncrement is true if the column is an auto-increment or identity
+	// column
+	autoIncrement bool
+	// collation is the name of the column's collation, if any
+	collation string
 }
 
 // Name returns the true name of the Column (not any alias)
@@ -38,15 +119,68 @@ func (c *Column) Name() string {
 	return c.name
 }
 
+// DataType returns the name of the Column's data type, e.g. "varchar", or an
+// empty string if the data type is not known
+func (c *Column) DataType() string {
+	return c.dataType
+}
+
+// Length returns the maximum length in characters of a character Column, or
+// 0 if the Column is not a character column or the length is not known
+func (c *Column) Length() int64 {
+	return c.length
+}
+
+// Precision returns the precision of a numeric Column, or 0 if the Column is
+// not a numeric column or the precision is not known
+func (c *Column) Precision() int64 {
+	return c.precision
+}
+
+// Scale returns the scale of a numeric Column, or 0 if the Column is not a
+// numeric column or the scale is not known
+func (c *Column) Scale() int64 {
+	return c.scale
+}
+
+// Nullable returns true if the Column accepts NULL values
+func (c *Column) Nullable() bool {
+	return c.nullable
+}
+
+// HasDefault returns true if the Column has a default value expression
+func (c *Column) HasDefault() bool {
+	return c.defaultExpr != nil
+}
+
+// Default returns the Column's default value expression, or an empty string
+// if the Column has no default
+func (c *Column) Default() string {
+	if c.defaultExpr == nil {
+		return ""
+	}
+	return *c.defaultExpr
+}
+
+// AutoIncrement returns true if the Column is an auto-increment (MySQL) or
+// identity/serial (PostgreSQL, T-SQL) column
+func (c *Column) AutoIncrement() bool {
+	return c.autoIncrement
+}
+
+// Collation returns the name of the Column's collation, or an empty string if
+// the Column has no collation or the collation is not known
+func (c *Column) Collation() string {
+	return c.collation
+}
+
 // As returns a copy of the Column aliased as the supplied name
 func (c *Column) As(
 	alias string,
 ) types.Projection {
-	return &Column{
-		t:     c.t,
-		name:  c.name,
-		alias: alias,
-	}
+	ac := *c
+	ac.alias = alias
+	return &ac
 }
 
 // References returns a slice of tables or derived tables that are referenced
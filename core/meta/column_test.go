@@ -0,0 +1,62 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnDefinition(t *testing.T) {
+	assert := assert.New(t)
+
+	tbl := meta.NewTable(nil, "prices")
+	c := meta.NewColumn(
+		tbl, "amount",
+		meta.WithDataType("decimal"),
+		meta.WithPrecision(10),
+		meta.WithScale(2),
+		meta.WithDefault("0.00"),
+	)
+	assert.Equal("decimal", c.DataType())
+	assert.Equal(int64(0), c.Length())
+	assert.Equal(int64(10), c.Precision())
+	assert.Equal(int64(2), c.Scale())
+	assert.False(c.Nullable())
+	assert.True(c.HasDefault())
+	assert.Equal("0.00", c.Default())
+	assert.False(c.AutoIncrement())
+	assert.Equal("", c.Collation())
+
+	// Aliasing a column must retain its definition
+	ac := c.As("price").(*meta.Column)
+	assert.Equal("decimal", ac.DataType())
+	assert.Equal(int64(10), ac.Precision())
+	assert.Equal("0.00", ac.Default())
+
+	c = meta.NewColumn(
+		tbl, "id",
+		meta.WithDataType("int"),
+		meta.WithAutoIncrement(),
+	)
+	assert.True(c.AutoIncrement())
+	assert.False(c.HasDefault())
+	assert.Equal("", c.Default())
+
+	c = meta.NewColumn(
+		tbl, "currency",
+		meta.WithDataType("varchar"),
+		meta.WithLength(3),
+		meta.WithNullable(true),
+		meta.WithCollation("utf8mb4_bin"),
+	)
+	assert.Equal(int64(3), c.Length())
+	assert.True(c.Nullable())
+	assert.Equal("utf8mb4_bin", c.Collation())
+}
@@ -0,0 +1,88 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package meta
+
+import (
+	"slices"
+	"strings"
+)
+
+// UniqueConstraint describes a PRIMARY KEY or UNIQUE constraint on a Table
+type UniqueConstraint struct {
+	// Name is the name of the constraint
+	Name string
+	// Columns is the ordered list of names of the columns in the constraint
+	Columns []string
+}
+
+// ForeignKey describes a FOREIGN KEY constraint on a Table
+type ForeignKey struct {
+	// Name is the name of the constraint
+	Name string
+	// Columns is the ordered list of names of the referencing columns in the
+	// Table having the foreign key
+	Columns []string
+	// ReferencedSchema is the name of the schema containing the table the
+	// foreign key refers to, if known
+	ReferencedSchema string
+	// ReferencedTable is the name of the table the foreign key refers to
+	ReferencedTable string
+	// ReferencedColumns is the ordered list of names of the columns in the
+	// referenced table, corresponding to the names in Columns
+	ReferencedColumns []string
+}
+
+// References returns true if the ForeignKey refers to the supplied Table. The
+// schema names are only compared if both the ForeignKey's referenced schema
+// and the Table's schema are known.
+func (fk *ForeignKey) References(t *Table) bool {
+	if fk.ReferencedSchema != "" && t.schema != "" &&
+		!strings.EqualFold(fk.ReferencedSchema, t.schema) {
+		return false
+	}
+	return strings.EqualFold(fk.ReferencedTable, t.name)
+}
+
+// PrimaryKey returns the Table's PRIMARY KEY constraint, or nil if the Table
+// has no primary key or the primary key is not known
+func (t *Table) PrimaryKey() *UniqueConstraint {
+	return t.primaryKey
+}
+
+// SetPrimaryKey sets the Table's PRIMARY KEY constraint to the supplied
+// constraint name and columns
+func (t *Table) SetPrimaryKey(name string, cols ...string) {
+	t.primaryKey = &UniqueConstraint{
+		Name:    name,
+		Columns: slices.Clone(cols),
+	}
+}
+
+// UniqueConstraints returns the Table's UNIQUE constraints, not including the
+// primary key
+func (t *Table) UniqueConstraints() []*UniqueConstraint {
+	return t.uniques
+}
+
+// AddUniqueConstraint adds a UNIQUE constraint with the supplied constraint
+// name and columns to the Table
+func (t *Table) AddUniqueConstraint(name string, cols ...string) {
+	t.uniques = append(t.uniques, &UniqueConstraint{
+		Name:    name,
+		Columns: slices.Clone(cols),
+	})
+}
+
+// ForeignKeys returns the Table's FOREIGN KEY constraints
+func (t *Table) ForeignKeys() []*ForeignKey {
+	return t.foreignKeys
+}
+
+// AddForeignKey adds the supplied FOREIGN KEY constraint to the Table
+func (t *Table) AddForeignKey(fk *ForeignKey) {
+	t.foreignKeys = append(t.foreignKeys, fk)
+}
@@ -0,0 +1,41 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package meta
+
+// Index describes an index on a Table
+type Index struct {
+	// Name is the name of the index
+	Name string
+	// Columns is the ordered list of names of the columns in the index. An
+	// empty string is used for any key part of the index that is an
+	// expression instead of a column.
+	Columns []string
+	// Expressions holds, for each key part in Columns, the text of the
+	// expression if the key part is an expression, or an empty string. The
+	// text is not reported by SQLite, for which only Expression is set.
+	Expressions []string
+	// Unique is true if the index enforces uniqueness of its key
+	Unique bool
+	// Primary is true if the index is the table's primary key
+	Primary bool
+	// Partial is true if the index only covers rows matching a predicate.
+	// MySQL has no partial indexes, so it is always false for MySQL.
+	Partial bool
+	// Expression is true if any key part of the index is an expression
+	// instead of a column
+	Expression bool
+}
+
+// Indexes returns the Table's indexes
+func (t *Table) Indexes() []*Index {
+	return t.indexes
+}
+
+// AddIndex adds the supplied Index to the Table
+func (t *Table) AddIndex(idx *Index) {
+	t.indexes = append(t.indexes, idx)
+}
@@ -24,9 +24,50 @@ type Meta struct {
 	Dialect types.Dialect
 	// Name is the actual name of the table within the database.
 	Name string
+	// Version is the version of the RDBMS, if known. It can be passed to
+	// types.WithDialectVersion.
+	Version string
 	// Tables is a map, keyed by the table name, of pointers to Table structs
-	// describing tables in this database.
+	// describing tables in the database's default schema.
 	Tables map[string]*Table
+	// Schemas is a map, keyed by the schema name, of pointers to Schema
+	// structs describing the schemas in this database that are known.
+	Schemas map[string]*Schema
+}
+
+// Schema returns a pointer to a Schema with a name matching the supplied
+// string, or nil if no such schema is known
+//
+// The name matching is done using case-insensitive matching, since this is how
+// the SQL standard works for identifiers and symbols.
+func (m *Meta) Schema(name string) *Schema {
+	if s, ok := m.Schemas[name]; ok {
+		return s
+	}
+	for _, s := range m.Schemas {
+		if strings.EqualFold(s.name, name) {
+			return s
+		}
+	}
+	return nil
+}
+
+// AddSchema returns a pointer to the Schema with the supplied name, adding a
+// new empty Schema if no such schema is known
+func (m *Meta) AddSchema(name string) *Schema {
+	if s := m.Schema(name); s != nil {
+		return s
+	}
+	if m.Schemas == nil {
+		m.Schemas = map[string]*Schema{}
+	}
+	s := &Schema{
+		m:      m,
+		name:   name,
+		Tables: map[string]*Table{},
+	}
+	m.Schemas[name] = s
+	return s
 }
 
 // Table returns a pointer to a Table with a name matching the supplied string,
@@ -0,0 +1,72 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package meta
+
+import "strings"
+
+// Schema describes a named collection of tables within a database
+type Schema struct {
+	// m is a pointer at the metadata collection for the database.
+	m *Meta
+	// name is the name of the schema in the database.
+	name string
+	// Tables is a map, keyed by the table name, of pointers to Table structs
+	// describing tables in this schema.
+	Tables map[string]*Table
+}
+
+// Name returns the name of the Schema
+func (s *Schema) Name() string {
+	return s.name
+}
+
+// Meta returns the metadata associated with the underlying RDBMS
+func (s *Schema) Meta() *Meta {
+	return s.m
+}
+
+// AddTable adds the supplied Table to the Schema, overwriting any
+// same-named Table.
+func (s *Schema) AddTable(t *Table) {
+	if s.Tables == nil {
+		s.Tables = map[string]*Table{}
+	}
+	s.Tables[t.name] = t
+}
+
+// Table returns a pointer to a Table in the Schema with a name matching the
+// supplied string, or nil if no such table is known. It is safe to call on a
+// nil Schema, allowing `m.Schema("billing").T("invoices")` to return nil when
+// the "billing" schema is not known.
+//
+// The name matching is done using case-insensitive matching, since this is how
+// the SQL standard works for identifiers and symbols (even though Microsoft
+// SQL Server uses case-sensitive identifier names).
+func (s *Schema) Table(name string) *Table {
+	if s == nil {
+		return nil
+	}
+	if t, ok := s.Tables[name]; ok {
+		return t
+	}
+	for _, t := range s.Tables {
+		if strings.EqualFold(t.name, name) {
+			return t
+		}
+	}
+	return nil
+}
+
+// T returns a pointer to a Table in the Schema with a name matching the
+// supplied string, or nil if no such table is known
+//
+// The name matching is done using case-insensitive matching, since this is how
+// the SQL standard works for identifiers and symbols (even though Microsoft
+// SQL Server uses case-sensitive identifier names).
+func (s *Schema) T(name string) *Table {
+	return s.Table(name)
+}
@@ -45,12 +45,28 @@ type Table struct {
 	m *Meta
 	// Name is the name of the table in the database.
 	name string
+	// schema is the name of the schema containing the table. When empty, the
+	// table name is not qualified with a schema name in SQL statements.
+	schema string
 	// Columns is a map of Column structs, keyed by the column's actual name
 	// (not alias).
 	columns map[string]types.Projection
 	// Alias is any alias/correlation name given to this Table for use in a
 	// SELECT statement
 	alias string
+	// primaryKey is the table's PRIMARY KEY constraint, if known
+	primaryKey *UniqueConstraint
+	// uniques are the table's UNIQUE constraints
+	uniques []*UniqueConstraint
+	// foreignKeys are the table's FOREIGN KEY constraints
+	foreignKeys []*ForeignKey
+	// indexes are the table's indexes
+	indexes []*Index
+	// kind is the kind of relation, e.g. a base table or a view
+	kind TableKind
+	// updatable is true if the relation is a view that can be the target of
+	// INSERT, UPDATE and DELETE statements
+	updatable bool
 }
 
 // Meta returns the metadata associated with the underlying RDBMS
@@ -79,6 +95,29 @@ func (t *Table) Name() string {
 	return t.name
 }
 
+// Schema returns the name of the schema containing the Table, or an empty
+// string if the Table's name is not qualified with a schema name
+func (t *Table) Schema() string {
+	return t.schema
+}
+
+// SetSchema sets the name of the schema containing the Table. Once set, the
+// table name is qualified with the schema name in SQL statements, e.g.
+// "billing.invoices".
+func (t *Table) SetSchema(schema string) {
+	t.schema = schema
+}
+
+// schemaName returns a pointer to the Table's schema name, or nil if the
+// Table's name is not qualified with a schema name
+func (t *Table) schemaName() *string {
+	if t.schema == "" {
+		return nil
+	}
+	schema := t.schema
+	return &schema
+}
+
 // Alias returns the aliased name of the Table
 func (t *Table) Alias() string {
 	return t.alias
@@ -109,19 +148,25 @@ func (t *Table) Projections() []types.Projection {
 // As returns a copy of the Table, aliased to the supplied name
 func (t *Table) As(alias string) *Table {
 	at := &Table{
-		m:     t.m,
-		name:  t.name,
-		alias: alias,
+		m:           t.m,
+		name:        t.name,
+		schema:      t.schema,
+		alias:       alias,
+		primaryKey:  t.primaryKey,
+		uniques:     t.uniques,
+		foreignKeys: t.foreignKeys,
+		indexes:     t.indexes,
+		kind:        t.kind,
+		updatable:   t.updatable,
 	}
 	// Build a copy of the table's columns and point those columns to the new
 	// aliased table
 	atCols := make(map[string]types.Projection, len(t.columns))
 	for k, c := range t.columns {
-		atc := &Column{
-			name: c.Name(),
-			t:    at,
-		}
-		atCols[k] = atc
+		atc := *c.(*Column)
+		atc.t = at
+		atc.alias = ""
+		atCols[k] = &atc
 	}
 	at.columns = atCols
 	return at
@@ -177,7 +222,8 @@ func (t *Table) QuerySpecification() *grammar.QuerySpecification {
 func (t *Table) TablePrimary() *grammar.TablePrimary {
 	tname := t.Name()
 	tp := &grammar.TablePrimary{
-		TableName: &tname,
+		SchemaName: t.schemaName(),
+		TableName:  &tname,
 	}
 	if t.alias != "" {
 		tp.Correlation = &grammar.Correlation{
@@ -194,52 +240,81 @@ func (t *Table) TableReference() *grammar.TableReference {
 
 // Insert returns an InstanceStatement that produces an INSERT SQL statement
 // for the table and map of column name to value for that column to insert,
+// in column name order.
 func (t *Table) Insert(
 	values map[string]interface{},
 ) (*grammar.InsertStatement, error) {
 	if t == nil {
 		return nil, types.TableRequired
 	}
+	if err := t.CheckUpdatable("INSERT INTO"); err != nil {
+		return nil, err
+	}
 	if len(values) == 0 {
 		return nil, types.NoValues
 	}
 
-	// Make sure all keys in the map point to actual columns in the target
-	// table.
-	cols := make([]string, len(values))
-	vals := make([]interface{}, len(values))
-	x := 0
+	cols, vals, err := t.columnValues(values)
+	if err != nil {
+		return nil, err
+	}
+	return &grammar.InsertStatement{
+		SchemaName: t.schemaName(),
+		TableName:  t.name,
+		Columns:    cols,
+		Values:     vals,
+	}, nil
+}
+
+// columnValues returns the names of the columns and the values in the
+// supplied map of column name to value, ordered by column name so that the
+// same map always produces the same SQL. Every key in the map must refer to
+// a column in the table.
+func (t *Table) columnValues(
+	values map[string]interface{},
+) ([]string, []interface{}, error) {
+	byName := make(map[string]interface{}, len(values))
+	cols := make([]string, 0, len(values))
 	for k, v := range values {
 		c := t.C(k)
 		if c == nil {
-			return nil, types.UnknownColumn
+			return nil, nil, types.UnknownColumn
 		}
-		cols[x] = c.Name()
-		vals[x] = v
-		x++
+		byName[c.Name()] = v
+		cols = append(cols, c.Name())
 	}
-
-	return &grammar.InsertStatement{
-		TableName: t.name,
-		Columns:   cols,
-		Values:    vals,
-	}, nil
+	slices.Sort(cols)
+	vals := make([]interface{}, len(cols))
+	for x, name := range cols {
+		vals[x] = byName[name]
+	}
+	return cols, vals, nil
 }
 
 // DeleteAll returns a `*grammar.DeleteStatementSearched` that will produce a
 // DELETE SQL statement **with no WHERE clause**.
+//
+// DeleteAll does not check that the Table is updatable. Use expr.DeleteE(),
+// which returns an error for read-only views.
 func (t *Table) DeleteAll() *grammar.DeleteStatementSearched {
 	return &grammar.DeleteStatementSearched{
-		TableName: t.name,
+		SchemaName: t.schemaName(),
+		TableName:  t.name,
 	}
 }
 
 // DeleteWhere returns the `*grammar.DeleteStatementSearched` adapted with a
 // supplied search condition. The argument must be coercible into a Boolean
 // Value Expression.
+//
+// Deprecated: use expr.Delete(t).Where(...), which returns a chainable
+// DeleteStatement and does not panic via its WhereE form.
 func (t *Table) Delete(
 	expr interface{},
 ) *grammar.DeleteStatementSearched {
+	if err := t.CheckUpdatable("DELETE FROM"); err != nil {
+		panic(err)
+	}
 	bve := inspect.BooleanValueExpressionFromAny(expr)
 	if bve == nil {
 		msg := fmt.Sprintf(
@@ -249,46 +324,114 @@ func (t *Table) Delete(
 		panic(msg)
 	}
 	return &grammar.DeleteStatementSearched{
-		TableName: t.name,
+		SchemaName: t.schemaName(),
+		TableName:  t.name,
 		Where: &grammar.WhereClause{
 			Search: *bve,
 		},
 	}
 }
 
+// TruncateOption modifies the TRUNCATE TABLE statement produced by
+// Table.Truncate()
+type TruncateOption func(*grammar.TruncateTableStatement)
+
+// TruncateRestartIdentity instructs Table.Truncate() to reset any
+// identity/sequence columns owned by the table. This is only output for
+// PostgreSQL, since MySQL and T-SQL always reset AUTO_INCREMENT and IDENTITY
+// columns when truncating a table.
+func TruncateRestartIdentity() TruncateOption {
+	return func(s *grammar.TruncateTableStatement) {
+		s.RestartIdentity = true
+	}
+}
+
+// TruncateCascade instructs Table.Truncate() to also truncate any tables that
+// have foreign key references to the table. This is only output for
+// PostgreSQL.
+func TruncateCascade() TruncateOption {
+	return func(s *grammar.TruncateTableStatement) {
+		s.Cascade = true
+	}
+}
+
+// Truncate returns a `*grammar.TruncateTableStatement` that will produce a
+// TRUNCATE TABLE SQL statement for the table. For SQLite, which has no
+// TRUNCATE statement, a DELETE SQL statement with no WHERE clause is
+// produced.
+//
+// Truncate panics if the Table is a view or materialized view. This is
+// intentional, as we want compile-time failures for invalid SQL construction.
+//
+// If you are constructing SQL expressions dynamically with user-supplied
+// input, use the `TruncateE` function which returns a checkable `error`
+// object.
+func (t *Table) Truncate(
+	opts ...TruncateOption,
+) *grammar.TruncateTableStatement {
+	ts, err := t.TruncateE(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+// TruncateE returns a `*grammar.TruncateTableStatement` that will produce a
+// TRUNCATE TABLE SQL statement for the table. If the Table is a view or
+// materialized view, which cannot be truncated even when the view is
+// updatable, an error wrapping types.ReadOnlyRelation is returned.
+func (t *Table) TruncateE(
+	opts ...TruncateOption,
+) (*grammar.TruncateTableStatement, error) {
+	if t.IsView() {
+		return nil, fmt.Errorf(
+			"%w: cannot TRUNCATE %s '%s'",
+			types.ReadOnlyRelation, t.kind, t.name,
+		)
+	}
+	ts := &grammar.TruncateTableStatement{
+		SchemaName: t.schemaName(),
+		TableName:  t.name,
+	}
+	for _, opt := range opts {
+		opt(ts)
+	}
+	return ts, nil
+}
+
 // UpdateAll returns a `grammar.UpdateStatementSearched` that will produce an
 // UPDATE SQL statement **with no WHERE clause**.
 //
 // The supplied map of values is keyed by the column name the value will be
-// updated to.
+// updated to. The columns are SET in column name order.
 func (t *Table) UpdateAll(
 	values map[string]interface{},
 ) (*grammar.UpdateStatementSearched, error) {
+	if err := t.CheckUpdatable("UPDATE"); err != nil {
+		return nil, err
+	}
 	if len(values) == 0 {
 		return nil, types.NoValues
 	}
-	cols := make([]string, len(values))
-	vals := make([]interface{}, len(values))
-	x := 0
-	for k, v := range values {
-		c := t.C(k)
-		if c == nil {
-			return nil, types.UnknownColumn
-		}
-		cols[x] = k
-		vals[x] = v
-		x++
+	cols, vals, err := t.columnValues(values)
+	if err != nil {
+		return nil, err
 	}
 	return &grammar.UpdateStatementSearched{
-		TableName: t.name,
-		Columns:   cols,
-		Values:    vals,
+		SchemaName: t.schemaName(),
+		TableName:  t.name,
+		Columns:    cols,
+		Values:     vals,
 	}, nil
 }
 
 // Update returns a `*grammar.UpdateStatementSearched` adapted with the
 // supplied search condition. The first argument must be coercible into a
 // Boolean Value Expression.
+//
+// Deprecated: use expr.Update(t, values).Where(...), which returns a
+// chainable UpdateStatement and does not panic via its UpdateE and WhereE
+// forms.
 func (t *Table) Update(
 	expr interface{},
 	values map[string]interface{},
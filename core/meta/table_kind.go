@@ -0,0 +1,86 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package meta
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// TableKind describes the kind of relation a Table represents
+type TableKind int
+
+const (
+	// TableKindBase is a base table
+	TableKindBase TableKind = iota
+	// TableKindView is a view
+	TableKindView
+	// TableKindMaterializedView is a materialized view (PostgreSQL only)
+	TableKindMaterializedView
+)
+
+// String returns the SQL name of the kind of relation
+func (k TableKind) String() string {
+	switch k {
+	case TableKindView:
+		return "VIEW"
+	case TableKindMaterializedView:
+		return "MATERIALIZED VIEW"
+	default:
+		return "TABLE"
+	}
+}
+
+// Kind returns the kind of relation the Table represents
+func (t *Table) Kind() TableKind {
+	return t.kind
+}
+
+// SetKind sets the kind of relation the Table represents. Views are
+// read-only unless they are subsequently marked updatable with
+// SetUpdatable().
+func (t *Table) SetKind(kind TableKind) {
+	t.kind = kind
+}
+
+// IsView returns true if the Table represents a view or materialized view
+func (t *Table) IsView() bool {
+	return t.kind != TableKindBase
+}
+
+// Updatable returns true if rows in the Table may be inserted, updated or
+// deleted. Base tables are always updatable. Views are only updatable if they
+// have been marked updatable with SetUpdatable(). Materialized views are
+// never updatable.
+func (t *Table) Updatable() bool {
+	switch t.kind {
+	case TableKindBase:
+		return true
+	case TableKindView:
+		return t.updatable
+	}
+	return false
+}
+
+// SetUpdatable marks a view as updatable or read-only
+func (t *Table) SetUpdatable(updatable bool) {
+	t.updatable = updatable
+}
+
+// CheckUpdatable returns an error wrapping types.ReadOnlyRelation if the
+// Table is not updatable. The supplied statement string is the SQL statement
+// that was attempted, e.g. "INSERT INTO".
+func (t *Table) CheckUpdatable(stmt string) error {
+	if t.Updatable() {
+		return nil
+	}
+	return fmt.Errorf(
+		"%w: cannot %s %s '%s'",
+		types.ReadOnlyRelation, stmt, t.kind, t.name,
+	)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/jaypipes/sqlb/internal/builder"
 	"github.com/jaypipes/sqlb/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTableDelete(t *testing.T) {
@@ -66,6 +67,13 @@ func TestTableInsert(t *testing.T) {
 			qs:     "INSERT INTO users (id) VALUES (?)",
 			qargs:  []interface{}{1},
 		},
+		{
+			name:   "Columns in name order",
+			t:      users,
+			values: map[string]interface{}{"name": "foo", "ID": 1},
+			qs:     "INSERT INTO users (id, name) VALUES (?, ?)",
+			qargs:  []interface{}{1, "foo"},
+		},
 	}
 	for _, tt := range tests {
 		got, err := tt.t.Insert(tt.values)
@@ -76,7 +84,7 @@ func TestTableInsert(t *testing.T) {
 		assert.Nil(err)
 		b := builder.New()
 		qs, qargs := b.StringArgs(got)
-		assert.Equal(len(tt.qargs), len(qargs))
+		assert.Equal(tt.qargs, qargs)
 		assert.Equal(tt.qs, qs)
 	}
 }
@@ -110,6 +118,12 @@ func TestTableUpdateAll(t *testing.T) {
 			qs:     "UPDATE users SET name = ?",
 			qargs:  []interface{}{"foo"},
 		},
+		{
+			name:   "Columns in name order",
+			values: map[string]interface{}{"NAME": "foo", "id": 1},
+			qs:     "UPDATE users SET id = ?, name = ?",
+			qargs:  []interface{}{1, "foo"},
+		},
 	}
 	for _, tt := range tests {
 		got, err := users.UpdateAll(tt.values)
@@ -120,7 +134,7 @@ func TestTableUpdateAll(t *testing.T) {
 		assert.Nil(err)
 		b := builder.New()
 		qs, qargs := b.StringArgs(got)
-		assert.Equal(len(tt.qargs), len(qargs))
+		assert.Equal(tt.qargs, qargs)
 		assert.Equal(tt.qs, qs)
 	}
 }
@@ -141,3 +155,206 @@ func TestTableUpdate(t *testing.T) {
 	assert.Equal(expqargs, qargs)
 	assert.Equal(expqs, qs)
 }
+
+func TestTableTruncate(t *testing.T) {
+	m := testutil.M()
+	users := m.T("users")
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		opts    []meta.TruncateOption
+		qs      string
+	}{
+		{
+			name:    "MySQL",
+			dialect: types.DialectMySQL,
+			opts:    []meta.TruncateOption{meta.TruncateCascade()},
+			qs:      "TRUNCATE TABLE users",
+		},
+		{
+			name:    "PostgreSQL no options",
+			dialect: types.DialectPostgreSQL,
+			qs:      "TRUNCATE TABLE users",
+		},
+		{
+			name:    "PostgreSQL RESTART IDENTITY CASCADE",
+			dialect: types.DialectPostgreSQL,
+			opts: []meta.TruncateOption{
+				meta.TruncateRestartIdentity(),
+				meta.TruncateCascade(),
+			},
+			qs: "TRUNCATE TABLE users RESTART IDENTITY CASCADE",
+		},
+		{
+			name:    "SQLite",
+			dialect: types.DialectSQLite,
+			qs:      "DELETE FROM users",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			b := builder.New(types.WithDialect(tt.dialect))
+			qs, qargs := b.StringArgs(users.Truncate(tt.opts...))
+			assert.Equal(tt.qs, qs)
+			assert.Empty(qargs)
+		})
+	}
+}
+
+func TestTableIndexes(t *testing.T) {
+	assert := assert.New(t)
+
+	tbl := meta.NewTable(nil, "articles", "id", "title", "author")
+	assert.Empty(tbl.Indexes())
+
+	tbl.AddIndex(&meta.Index{
+		Name:    "PRIMARY",
+		Columns: []string{"id"},
+		Unique:  true,
+		Primary: true,
+	})
+	tbl.AddIndex(&meta.Index{
+		Name:    "ix_author_title",
+		Columns: []string{"author", "title"},
+	})
+	require.Len(t, tbl.Indexes(), 2)
+	assert.True(tbl.Indexes()[0].Primary)
+	assert.Equal([]string{"author", "title"}, tbl.Indexes()[1].Columns)
+
+	// Aliasing a table must retain its indexes and constraints
+	tbl.SetPrimaryKey("PRIMARY", "id")
+	at := tbl.As("a")
+	assert.Equal(tbl.Indexes(), at.Indexes())
+	assert.Equal(tbl.PrimaryKey(), at.PrimaryKey())
+}
+
+func TestTableView(t *testing.T) {
+	assert := assert.New(t)
+
+	v := meta.NewTable(nil, "published_articles", "id", "title")
+	assert.Equal(meta.TableKindBase, v.Kind())
+	assert.True(v.Updatable())
+
+	v.SetKind(meta.TableKindView)
+	assert.True(v.IsView())
+	assert.False(v.Updatable())
+
+	_, err := v.Insert(map[string]interface{}{"title": "foo"})
+	assert.ErrorIs(err, types.ReadOnlyRelation)
+	assert.Contains(err.Error(), "VIEW 'published_articles'")
+
+	_, err = v.UpdateAll(map[string]interface{}{"title": "foo"})
+	assert.ErrorIs(err, types.ReadOnlyRelation)
+
+	assert.Panics(func() { v.Delete(expr.Equal(v.C("id"), 1)) })
+
+	_, err = expr.DeleteE(v)
+	assert.ErrorIs(err, types.ReadOnlyRelation)
+
+	_, err = v.TruncateE()
+	assert.ErrorIs(err, types.ReadOnlyRelation)
+	assert.Contains(err.Error(), "TRUNCATE VIEW 'published_articles'")
+	assert.Panics(func() { v.Truncate() })
+
+	// Read-only views can still be selected from
+	b := builder.New()
+	qs, _ := b.StringArgs(expr.Select(v.C("title")).Query())
+	assert.Equal("SELECT published_articles.title FROM published_articles", qs)
+
+	v.SetUpdatable(true)
+	assert.True(v.Updatable())
+	_, err = v.Insert(map[string]interface{}{"title": "foo"})
+	assert.Nil(err)
+
+	// Even updatable views cannot be truncated
+	_, err = v.TruncateE()
+	assert.ErrorIs(err, types.ReadOnlyRelation)
+
+	mv := meta.NewTable(nil, "article_counts", "author", "num_articles")
+	mv.SetKind(meta.TableKindMaterializedView)
+	mv.SetUpdatable(true)
+	assert.False(mv.Updatable())
+	assert.False(mv.As("ac").Updatable())
+	_, err = mv.TruncateE()
+	assert.ErrorIs(err, types.ReadOnlyRelation)
+}
+
+func TestTableSchema(t *testing.T) {
+	m := &meta.Meta{Tables: map[string]*meta.Table{}}
+	billing := m.AddSchema("billing")
+	invoices := meta.NewTable(m, "invoices", "id", "amount")
+	invoices.SetSchema("billing")
+	billing.AddTable(invoices)
+
+	require.Same(t, billing, m.Schema("BILLING"))
+	require.Same(t, invoices, m.Schema("billing").T("Invoices"))
+	assert.Nil(t, m.T("invoices"))
+	assert.Nil(t, m.Schema("unknown").T("invoices"))
+	assert.Equal(t, "billing", invoices.As("i").Schema())
+
+	colID := invoices.C("id")
+	update, err := expr.UpdateE(invoices, map[string]interface{}{"amount": 0})
+	require.Nil(t, err)
+	insert, err := invoices.Insert(map[string]interface{}{"amount": 1})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name    string
+		dialect types.Dialect
+		target  interface{}
+		qs      string
+	}{
+		{
+			name:   "SELECT",
+			target: expr.Select(colID).Query(),
+			qs:     "SELECT invoices.id FROM billing.invoices",
+		},
+		{
+			name:   "SELECT aliased",
+			target: expr.Select(invoices.As("i").C("id")).Query(),
+			qs:     "SELECT i.id FROM billing.invoices AS i",
+		},
+		{
+			name:   "INSERT",
+			target: insert,
+			qs:     "INSERT INTO billing.invoices (amount) VALUES (?)",
+		},
+		{
+			name:   "UPDATE",
+			target: update.UpdateStatementSearched(),
+			qs:     "UPDATE billing.invoices SET amount = ?",
+		},
+		{
+			name:    "UPDATE with LIMIT PostgreSQL",
+			dialect: types.DialectPostgreSQL,
+			target: expr.Update(
+				invoices, map[string]interface{}{"amount": 0},
+			).Limit(1).UpdateStatementSearched(),
+			qs: "UPDATE billing.invoices SET amount = $1 WHERE ctid IN " +
+				"(SELECT ctid FROM billing.invoices LIMIT $2)",
+		},
+		{
+			name:   "DELETE",
+			target: expr.Delete(invoices).DeleteStatementSearched(),
+			qs:     "DELETE FROM billing.invoices",
+		},
+		{
+			name:   "TRUNCATE",
+			target: invoices.Truncate(),
+			qs:     "TRUNCATE TABLE billing.invoices",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialect := tt.dialect
+			if dialect == types.DialectUnknown {
+				dialect = types.DialectMySQL
+			}
+			b := builder.New(types.WithDialect(dialect))
+			qs, _ := b.StringArgs(tt.target)
+			assert.Equal(t, tt.qs, qs)
+		})
+	}
+}
@@ -9,15 +9,13 @@ package reflect
 import (
 	"database/sql"
 
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/types"
 )
 
-const (
-	selDBNameMySQL      = "SELECT DATABASE()"
-	selDBNamePostgreSQL = "SELECT CURRENT_DATABASE()"
-)
-
-// DatabaseName returns the database schema name given a sql.DB handle
+// DatabaseName returns the database schema name given a sql.DB handle. For
+// SQLite, this is the name of the schema the connection's database file is
+// attached as, which is always "main".
 func DatabaseName(
 	db *sql.DB,
 	mods ...types.Option,
@@ -29,15 +27,12 @@ func DatabaseName(
 	} else {
 		d = opts.Dialect()
 	}
-	var qs string
-	switch d {
-	case types.DialectMySQL:
-		qs = selDBNameMySQL
-	case types.DialectPostgreSQL:
-		qs = selDBNamePostgreSQL
+	r := dialect.Get(d).Reflection()
+	if r == nil || r.DatabaseName == "" {
+		return ""
 	}
 	var dbName string
-	if err := db.QueryRow(qs).Scan(&dbName); err != nil {
+	if err := db.QueryRow(r.DatabaseName).Scan(&dbName); err != nil {
 		return ""
 	}
 	return dbName
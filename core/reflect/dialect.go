@@ -10,16 +10,10 @@ import (
 	"database/sql"
 	"reflect"
 
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/types"
 )
 
-var driverNameToDialect = map[string]types.Dialect{
-	"*mysql.MySQLDriver": types.DialectMySQL,
-	"*mssql.MssqlDriver": types.DialectTSQL,
-	"*pq.Driver":         types.DialectPostgreSQL,
-	"*stdlib.Driver":     types.DialectPostgreSQL,
-}
-
 // Dialect returns the SQL Dialect after examining the supplied database
 // connection
 func Dialect(
@@ -27,9 +21,5 @@ func Dialect(
 ) types.Dialect {
 	drv := db.Driver()
 	dv := reflect.ValueOf(drv)
-	d, found := driverNameToDialect[dv.Type().String()]
-	if !found {
-		return types.DialectUnknown
-	}
-	return d
+	return dialect.ForDriver(dv.Type().String())
 }
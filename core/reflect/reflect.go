@@ -9,31 +9,20 @@ package reflect
 import (
 	"database/sql"
 
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/meta"
 	"github.com/jaypipes/sqlb/core/types"
 )
 
-const (
-	selTablesMySQL = `
-SELECT t.TABLE_NAME
-FROM INFORMATION_SCHEMA.TABLES AS t
-WHERE t.TABLE_TYPE = 'BASE TABLE'
-AND t.TABLE_SCHEMA = ?
-ORDER BY t.TABLE_NAME
-`
-	selTablesPostgreSQL = `
-SELECT t.TABLE_NAME
-FROM INFORMATION_SCHEMA.TABLES AS t
-WHERE t.TABLE_SCHEMA = 'public'
-AND t.TABLE_CATALOG = $1
-AND t.TABLE_TYPE = 'BASE TABLE'
-ORDER BY t.TABLE_NAME
-`
-)
-
 // Reflect examines the supplied database connection and discovers Table
 // definitions within that connection's associated database, returning a
 // pointer to a [core/meta] Meta struct with the discovered information.
+//
+// By default, only the tables in the default schema are reflected: the
+// "public" schema for PostgreSQL, the "dbo" schema for T-SQL, the connection's
+// database for MySQL and the "main" schema for SQLite.
+// Pass types.WithSchemas or types.WithAllSchemas to reflect the tables in
+// other schemas, which are then available using `Meta.Schema(name)`.
 func Reflect(
 	db *sql.DB,
 	mods ...types.Option,
@@ -51,12 +40,34 @@ func Reflect(
 		DB:      db,
 		Dialect: d,
 		Name:    dbName,
+		Version: Version(db, types.WithDialect(d)),
 		Tables:  map[string]*meta.Table{},
+		Schemas: map[string]*meta.Schema{},
+	}
+	r := dialect.Get(d).Reflection()
+	if r == nil {
+		return nil, types.ReflectionNotSupported
+	}
+	f := newSchemaFilter(m, r, &opts)
+	if r.SQLiteCatalog {
+		if err = reflectSQLite(db, f); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+	if err = fillTables(db, f); err != nil {
+		return nil, err
+	}
+	if err = fillTableColumns(db, f); err != nil {
+		return nil, err
 	}
-	if err = fillTables(db, m); err != nil {
+	if err = fillTableConstraints(db, f); err != nil {
 		return nil, err
 	}
-	if err = fillTableColumns(db, m); err != nil {
+	if err = fillTableForeignKeys(db, f); err != nil {
+		return nil, err
+	}
+	if err = fillTableIndexes(db, f); err != nil {
 		return nil, err
 	}
 	return m, nil
@@ -66,77 +77,327 @@ func Reflect(
 // the INFORMATION_SCHEMA in the associated database.
 func fillTables(
 	db *sql.DB,
-	m *meta.Meta,
+	f *schemaFilter,
 ) error {
-	var qs string
-	switch m.Dialect {
-	case types.DialectMySQL:
-		qs = selTablesMySQL
-	case types.DialectPostgreSQL:
-		qs = selTablesPostgreSQL
-	}
-	// Grab information about all tables in the schema
-	rows, err := db.Query(qs, m.Name)
+	for _, q := range f.r.Tables {
+		if !f.runs(q) {
+			continue
+		}
+		if err := fillTablesFrom(db, f, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillTablesFrom executes the supplied table information query and adds the
+// resulting tables to the supplied `Meta`
+func fillTablesFrom(
+	db *sql.DB,
+	f *schemaFilter,
+	q dialect.ReflectionQuery,
+) error {
+	qs, args := f.query(q.SQL, q.SchemaColumns...)
+	// Grab information about all tables in the schemas
+	rows, err := db.Query(qs, args...)
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
 	for rows.Next() {
+		var sName string
 		var tName string
-		if err = rows.Scan(&tName); err != nil {
+		var tType string
+		var updatable string
+		if err = rows.Scan(&sName, &tName, &tType, &updatable); err != nil {
 			return err
 		}
-		t := meta.NewTable(m, tName)
-		m.Tables[tName] = t
+		t := meta.NewTable(f.m, tName)
+		switch tType {
+		case "VIEW":
+			t.SetKind(meta.TableKindView)
+			t.SetUpdatable(updatable == "YES")
+		case "MATERIALIZED VIEW":
+			t.SetKind(meta.TableKindMaterializedView)
+		}
+		f.addTable(sName, t)
 	}
-	return nil
+	return rows.Err()
 }
 
 // fillTableColumns grabs column information from the information schema and
 // populates the supplied `Meta`'s map of Table's columns
 func fillTableColumns(
 	db *sql.DB,
-	m *meta.Meta,
+	f *schemaFilter,
+) error {
+	for _, q := range f.r.Columns {
+		if !f.runs(q) {
+			continue
+		}
+		if err := fillColumns(db, f, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillColumns executes the supplied column information query, restricted to
+// the requested schemas, and adds the resulting columns to the supplied
+// `Meta`'s Tables
+func fillColumns(
+	db *sql.DB,
+	f *schemaFilter,
+	q dialect.ReflectionQuery,
 ) error {
-	var qs string
-	switch m.Dialect {
-	case types.DialectMySQL:
-		qs = `
-SELECT c.TABLE_NAME, c.COLUMN_NAME
-FROM INFORMATION_SCHEMA.COLUMNS AS c
-JOIN INFORMATION_SCHEMA.TABLES AS t
- ON t.TABLE_SCHEMA = c.TABLE_SCHEMA
- AND t.TABLE_NAME = c.TABLE_NAME
-WHERE c.TABLE_SCHEMA = ?
-AND t.TABLE_TYPE = 'BASE TABLE'
-ORDER BY c.TABLE_NAME, c.COLUMN_NAME
-`
-	case types.DialectPostgreSQL:
-		qs = `
-SELECT c.TABLE_NAME, c.COLUMN_NAME
-FROM INFORMATION_SCHEMA.COLUMNS AS c
-JOIN INFORMATION_SCHEMA.TABLES AS t
- ON t.TABLE_SCHEMA = c.TABLE_SCHEMA
- AND t.TABLE_NAME = c.TABLE_NAME
-WHERE c.TABLE_SCHEMA = 'public'
-AND c.TABLE_CATALOG = $1
-AND t.TABLE_TYPE = 'BASE TABLE'
-ORDER BY c.TABLE_NAME, c.COLUMN_NAME
-`
-	}
-	rows, err := db.Query(qs, m.Name)
+	qs, args := f.query(q.SQL, q.SchemaColumns...)
+	rows, err := db.Query(qs, args...)
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
 	var t *meta.Table
 	for rows.Next() {
+		var sname string
 		var tname string
 		var cname string
-		err = rows.Scan(&tname, &cname)
+		var dataType string
+		var length sql.NullInt64
+		var precision sql.NullInt64
+		var scale sql.NullInt64
+		var nullable string
+		var defaultExpr sql.NullString
+		var autoIncrement bool
+		var collation sql.NullString
+		err = rows.Scan(
+			&sname,
+			&tname,
+			&cname,
+			&dataType,
+			&length,
+			&precision,
+			&scale,
+			&nullable,
+			&defaultExpr,
+			&autoIncrement,
+			&collation,
+		)
 		if err != nil {
 			return err
 		}
-		t = m.T(tname)
-		t.AddColumn(cname)
+		t = f.table(sname, tname)
+		if t == nil {
+			continue
+		}
+		opts := []meta.ColumnOption{
+			meta.WithDataType(dataType),
+			meta.WithLength(length.Int64),
+			meta.WithPrecision(precision.Int64),
+			meta.WithScale(scale.Int64),
+			meta.WithNullable(nullable == "YES"),
+			meta.WithCollation(collation.String),
+		}
+		if defaultExpr.Valid {
+			opts = append(opts, meta.WithDefault(defaultExpr.String))
+		}
+		if autoIncrement {
+			opts = append(opts, meta.WithAutoIncrement())
+		}
+		t.AddColumn(meta.NewColumn(t, cname, opts...))
+	}
+	return rows.Err()
+}
+
+// fillTableConstraints grabs PRIMARY KEY and UNIQUE constraint information
+// from the information schema and populates the supplied `Meta`'s Tables
+// with it
+func fillTableConstraints(
+	db *sql.DB,
+	f *schemaFilter,
+) error {
+	for _, q := range f.r.Constraints {
+		if !f.runs(q) {
+			continue
+		}
+		if err := fillConstraints(db, f, q); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// fillConstraints executes the supplied PRIMARY KEY and UNIQUE constraint
+// information query and adds the resulting constraints to the supplied
+// `Meta`'s Tables
+func fillConstraints(
+	db *sql.DB,
+	f *schemaFilter,
+	q dialect.ReflectionQuery,
+) error {
+	qs, args := f.query(q.SQL, q.SchemaColumns...)
+	rows, err := db.Query(qs, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var t *meta.Table
+	var sname, tname, cname, ctype string
+	cols := []string{}
+	// flush adds the constraint accumulated from the previous rows, if any,
+	// to its table
+	flush := func() {
+		if t == nil || len(cols) == 0 {
+			return
+		}
+		if ctype == "PRIMARY KEY" {
+			t.SetPrimaryKey(cname, cols...)
+		} else {
+			t.AddUniqueConstraint(cname, cols...)
+		}
+		cols = []string{}
+	}
+	for rows.Next() {
+		var rsname, rtname, rcname, rctype, colname string
+		err = rows.Scan(&rsname, &rtname, &rcname, &rctype, &colname)
+		if err != nil {
+			return err
+		}
+		if rsname != sname || rtname != tname || rcname != cname {
+			flush()
+			t = f.table(rsname, rtname)
+			sname, tname, cname, ctype = rsname, rtname, rcname, rctype
+		}
+		cols = append(cols, colname)
+	}
+	flush()
+	return rows.Err()
+}
+
+// fillTableForeignKeys grabs FOREIGN KEY constraint information from the
+// information schema and populates the supplied `Meta`'s Tables with it
+func fillTableForeignKeys(
+	db *sql.DB,
+	f *schemaFilter,
+) error {
+	for _, q := range f.r.ForeignKeys {
+		if !f.runs(q) {
+			continue
+		}
+		if err := fillForeignKeys(db, f, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillForeignKeys executes the supplied FOREIGN KEY constraint information
+// query and adds the resulting foreign keys to the supplied `Meta`'s Tables
+func fillForeignKeys(
+	db *sql.DB,
+	f *schemaFilter,
+	q dialect.ReflectionQuery,
+) error {
+	qs, args := f.query(q.SQL, q.SchemaColumns...)
+	rows, err := db.Query(qs, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var t *meta.Table
+	var fk *meta.ForeignKey
+	var sname, tname string
+	for rows.Next() {
+		var rsname, rtname, rcname, colname string
+		var refsname, reftname, refcolname string
+		err = rows.Scan(
+			&rsname, &rtname, &rcname, &colname,
+			&refsname, &reftname, &refcolname,
+		)
+		if err != nil {
+			return err
+		}
+		if fk == nil || rsname != sname || rtname != tname ||
+			rcname != fk.Name {
+			t = f.table(rsname, rtname)
+			sname, tname = rsname, rtname
+			fk = &meta.ForeignKey{
+				Name:            rcname,
+				ReferencedTable: reftname,
+			}
+			if f.qualified() {
+				fk.ReferencedSchema = refsname
+			}
+			if t != nil {
+				t.AddForeignKey(fk)
+			}
+		}
+		fk.Columns = append(fk.Columns, colname)
+		fk.ReferencedColumns = append(fk.ReferencedColumns, refcolname)
+	}
+	return rows.Err()
+}
+
+// fillTableIndexes grabs index information from the database's catalog and
+// populates the supplied `Meta`'s Tables with it
+func fillTableIndexes(
+	db *sql.DB,
+	f *schemaFilter,
+) error {
+	for _, q := range f.r.Indexes {
+		if !f.runs(q) {
+			continue
+		}
+		if err := fillIndexes(db, f, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillIndexes executes the supplied index information query and adds the
+// resulting indexes to the supplied `Meta`'s Tables
+func fillIndexes(
+	db *sql.DB,
+	f *schemaFilter,
+	q dialect.ReflectionQuery,
+) error {
+	qs, args := f.query(q.SQL, q.SchemaColumns...)
+	rows, err := db.Query(qs, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var idx *meta.Index
+	var sname, tname string
+	for rows.Next() {
+		var rsname, rtname, iname, colname, expr string
+		var unique, primary, partial bool
+		err = rows.Scan(
+			&rsname, &rtname, &iname, &unique, &primary, &partial, &colname,
+			&expr,
+		)
+		if err != nil {
+			return err
+		}
+		if idx == nil || rsname != sname || rtname != tname ||
+			iname != idx.Name {
+			sname, tname = rsname, rtname
+			idx = &meta.Index{
+				Name:    iname,
+				Unique:  unique,
+				Primary: primary,
+				Partial: partial,
+			}
+			if t := f.table(rsname, rtname); t != nil {
+				t.AddIndex(idx)
+			}
+		}
+		// An expression key part has no column name
+		if colname == "" {
+			idx.Expression = true
+		}
+		idx.Columns = append(idx.Columns, colname)
+		idx.Expressions = append(idx.Expressions, expr)
+	}
+	return rows.Err()
+}
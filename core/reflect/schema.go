@@ -0,0 +1,136 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package reflect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/dialect"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// schemaFilter determines which schemas' tables are reflected and how the
+// reflected tables are added to a `Meta`
+type schemaFilter struct {
+	m       *meta.Meta
+	d       dialect.Dialect
+	r       *dialect.Reflection
+	schemas []string
+	all     bool
+}
+
+// newSchemaFilter returns a schemaFilter for the supplied `Meta`, the
+// Reflection of the `Meta`'s Dialect and Options
+func newSchemaFilter(
+	m *meta.Meta,
+	r *dialect.Reflection,
+	opts *types.Options,
+) *schemaFilter {
+	return &schemaFilter{
+		m:       m,
+		d:       dialect.Get(m.Dialect),
+		r:       r,
+		schemas: opts.Schemas(),
+		all:     opts.AllSchemas(),
+	}
+}
+
+// defaultSchema returns the name of the schema that unqualified table names
+// refer to. For MySQL, schemas are databases and so this is the name of the
+// connection's database.
+func (f *schemaFilter) defaultSchema() string {
+	if f.r.DefaultSchema != "" {
+		return f.r.DefaultSchema
+	}
+	return f.m.Name
+}
+
+// runs returns true if the supplied catalog query can be run against the
+// version of the RDBMS being reflected
+func (f *schemaFilter) runs(q dialect.ReflectionQuery) bool {
+	return q.Runs == nil || q.Runs(f.m.Version)
+}
+
+// qualified returns true if reflected tables should have their names
+// qualified with their schema name, which is the case when the tables of
+// schemas other than the default schema were requested.
+func (f *schemaFilter) qualified() bool {
+	return f.all || len(f.schemas) > 0
+}
+
+// query returns the supplied query string with each of its format verbs
+// replaced by a condition restricting the supplied schema name column to the
+// requested schemas, along with the query arguments.
+//
+// If the Reflection's CatalogArg is set, the database name is always the
+// first query argument.
+func (f *schemaFilter) query(qs string, cols ...string) (string, []any) {
+	args := []any{}
+	if f.r.CatalogArg {
+		args = append(args, f.m.Name)
+	}
+	conds := make([]any, len(cols))
+	for x, col := range cols {
+		conds[x] = f.condition(col, &args)
+	}
+	return fmt.Sprintf(qs, conds...), args
+}
+
+// marker returns the query argument marker for the query argument that
+// follows the supplied query arguments
+func (f *schemaFilter) marker(args []any) string {
+	return f.d.Placeholder(len(args))
+}
+
+// condition returns a condition restricting the supplied schema name column
+// to the requested schemas, appending any query arguments to args
+func (f *schemaFilter) condition(col string, args *[]any) string {
+	switch {
+	case len(f.schemas) > 0:
+		markers := make([]string, len(f.schemas))
+		for x, schema := range f.schemas {
+			markers[x] = f.marker(*args)
+			*args = append(*args, schema)
+		}
+		return fmt.Sprintf("%s IN (%s)", col, strings.Join(markers, ", "))
+	case f.all:
+		cond := fmt.Sprintf(
+			"%s NOT IN ('%s')", col, strings.Join(f.r.SystemSchemas, "', '"),
+		)
+		if f.r.SystemSchemaCondition != "" {
+			cond += " AND " + fmt.Sprintf(f.r.SystemSchemaCondition, col)
+		}
+		return cond
+	}
+	if f.r.DefaultSchema != "" {
+		return fmt.Sprintf("%s = '%s'", col, f.r.DefaultSchema)
+	}
+	cond := col + " = " + f.marker(*args)
+	*args = append(*args, f.m.Name)
+	return cond
+}
+
+// addTable adds the supplied Table, reflected from the supplied schema, to
+// the `Meta`. Tables in the default schema are also added to the `Meta`'s
+// Tables collection.
+func (f *schemaFilter) addTable(schema string, t *meta.Table) {
+	if f.qualified() {
+		t.SetSchema(schema)
+	}
+	f.m.AddSchema(schema).AddTable(t)
+	if schema == f.defaultSchema() {
+		f.m.Tables[t.Name()] = t
+	}
+}
+
+// table returns the previously reflected Table with the supplied schema and
+// table name, or nil if no such table was reflected
+func (f *schemaFilter) table(schema string, name string) *meta.Table {
+	return f.m.Schema(schema).T(name)
+}
@@ -0,0 +1,380 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package reflect
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/meta"
+)
+
+// SQLite has no INFORMATION_SCHEMA. Instead, each attached database (schema)
+// has a sqlite_master table listing its tables, views and indexes, and the
+// details of each table are available from table-valued pragma functions. The
+// pragma functions accept the schema name as their last argument.
+const (
+	selSchemasSQLite = `
+SELECT name
+FROM pragma_database_list
+WHERE name <> 'temp'
+ORDER BY seq
+`
+	selTablesSQLite = `
+SELECT m.name, m.type
+FROM %s.sqlite_master AS m
+WHERE m.type IN ('table', 'view')
+AND m.name NOT LIKE 'sqlite!_%%' ESCAPE '!'
+ORDER BY m.name
+`
+	selColumnsSQLite = `
+SELECT m.name, p.name, p.type, p."notnull", p.dflt_value, p.pk
+FROM %s.sqlite_master AS m
+JOIN pragma_table_info(m.name, ?) AS p
+WHERE m.type IN ('table', 'view')
+AND m.name NOT LIKE 'sqlite!_%%' ESCAPE '!'
+ORDER BY m.name, p.cid
+`
+	selIndexesSQLite = `
+SELECT m.name, il.name, il."unique", il.origin, il.partial, COALESCE(ii.name, '')
+FROM %s.sqlite_master AS m
+JOIN pragma_index_list(m.name, ?) AS il
+JOIN pragma_index_info(il.name, ?) AS ii
+WHERE m.type = 'table'
+AND m.name NOT LIKE 'sqlite!_%%' ESCAPE '!'
+ORDER BY m.name, il.name, ii.seqno
+`
+	selForeignKeysSQLite = `
+SELECT m.name, fk.id, fk."table", fk."from", fk."to"
+FROM %s.sqlite_master AS m
+JOIN pragma_foreign_key_list(m.name, ?) AS fk
+WHERE m.type = 'table'
+AND m.name NOT LIKE 'sqlite!_%%' ESCAPE '!'
+ORDER BY m.name, fk.id, fk.seq
+`
+)
+
+// reflectSQLite populates the supplied schemaFilter's `Meta` with the tables,
+// columns, constraints and indexes of each of the requested SQLite schemas
+func reflectSQLite(
+	db *sql.DB,
+	f *schemaFilter,
+) error {
+	schemas, err := sqliteSchemas(db, f)
+	if err != nil {
+		return err
+	}
+	for _, schema := range schemas {
+		if err = fillTablesSQLite(db, f, schema); err != nil {
+			return err
+		}
+		if err = fillColumnsSQLite(db, f, schema); err != nil {
+			return err
+		}
+		if err = fillIndexesSQLite(db, f, schema); err != nil {
+			return err
+		}
+		if err = fillForeignKeysSQLite(db, f, schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqliteSchemas returns the names of the SQLite schemas to reflect
+func sqliteSchemas(
+	db *sql.DB,
+	f *schemaFilter,
+) ([]string, error) {
+	if len(f.schemas) > 0 {
+		return f.schemas, nil
+	}
+	if !f.all {
+		return []string{f.defaultSchema()}, nil
+	}
+	rows, err := db.Query(selSchemasSQLite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	schemas := []string{}
+	for rows.Next() {
+		var schema string
+		if err = rows.Scan(&schema); err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, rows.Err()
+}
+
+// sqliteQuery returns the supplied SQLite query string with the schema
+// containing the sqlite_master table replaced by the supplied schema name
+func sqliteQuery(qs string, schema string) string {
+	quoted := `"` + strings.ReplaceAll(schema, `"`, `""`) + `"`
+	return fmt.Sprintf(qs, quoted)
+}
+
+// fillTablesSQLite adds the tables and views in the supplied SQLite schema to
+// the `Meta`. SQLite views are always read-only.
+func fillTablesSQLite(
+	db *sql.DB,
+	f *schemaFilter,
+	schema string,
+) error {
+	rows, err := db.Query(sqliteQuery(selTablesSQLite, schema))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tName string
+		var tType string
+		if err = rows.Scan(&tName, &tType); err != nil {
+			return err
+		}
+		t := meta.NewTable(f.m, tName)
+		if tType == "view" {
+			t.SetKind(meta.TableKindView)
+		}
+		f.addTable(schema, t)
+	}
+	return rows.Err()
+}
+
+// fillColumnsSQLite adds the columns and primary keys of the tables in the
+// supplied SQLite schema to the `Meta`'s Tables
+func fillColumnsSQLite(
+	db *sql.DB,
+	f *schemaFilter,
+	schema string,
+) error {
+	rows, err := db.Query(sqliteQuery(selColumnsSQLite, schema), schema)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	type pkColumn struct {
+		pos  int
+		name string
+		typ  string
+	}
+	pks := map[*meta.Table][]pkColumn{}
+	for rows.Next() {
+		var tname string
+		var cname string
+		var declType string
+		var notNull bool
+		var defaultExpr sql.NullString
+		var pk int
+		err = rows.Scan(
+			&tname, &cname, &declType, &notNull, &defaultExpr, &pk,
+		)
+		if err != nil {
+			return err
+		}
+		t := f.table(schema, tname)
+		if t == nil {
+			continue
+		}
+		dataType, length, precision, scale := parseSQLiteType(declType)
+		opts := []meta.ColumnOption{
+			meta.WithDataType(dataType),
+			meta.WithLength(length),
+			meta.WithPrecision(precision),
+			meta.WithScale(scale),
+			meta.WithNullable(!notNull && pk == 0),
+		}
+		if defaultExpr.Valid {
+			opts = append(opts, meta.WithDefault(defaultExpr.String))
+		}
+		t.AddColumn(meta.NewColumn(t, cname, opts...))
+		if pk > 0 {
+			pks[t] = append(pks[t], pkColumn{pk, cname, dataType})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	for t, cols := range pks {
+		slices.SortFunc(cols, func(a, b pkColumn) int {
+			return a.pos - b.pos
+		})
+		names := make([]string, len(cols))
+		for x, c := range cols {
+			names[x] = c.name
+		}
+		// SQLite primary key constraints are unnamed
+		t.SetPrimaryKey("", names...)
+		// A single INTEGER PRIMARY KEY column is an alias for the table's
+		// rowid and is assigned automatically when not supplied
+		if len(cols) == 1 && cols[0].typ == "integer" && !t.IsView() {
+			c := t.C(cols[0].name).(*meta.Column)
+			meta.WithAutoIncrement()(c)
+		}
+	}
+	return nil
+}
+
+// parseSQLiteType returns the data type, length, precision and scale from the
+// supplied SQLite column type declaration, e.g. "VARCHAR(100)" or
+// "DECIMAL(10, 2)". SQLite does not enforce lengths or precisions, but they
+// are recorded in the declared type.
+func parseSQLiteType(declType string) (string, int64, int64, int64) {
+	dataType := strings.ToLower(strings.TrimSpace(declType))
+	open := strings.IndexByte(dataType, '(')
+	if open < 0 {
+		return dataType, 0, 0, 0
+	}
+	args := strings.TrimSuffix(strings.TrimSpace(dataType[open+1:]), ")")
+	dataType = strings.TrimSpace(dataType[:open])
+	nums := []int64{}
+	for _, arg := range strings.Split(args, ",") {
+		n, err := strconv.ParseInt(strings.TrimSpace(arg), 10, 64)
+		if err != nil {
+			return dataType, 0, 0, 0
+		}
+		nums = append(nums, n)
+	}
+	switch {
+	case len(nums) == 2:
+		return dataType, 0, nums[0], nums[1]
+	case strings.Contains(dataType, "char") ||
+		strings.Contains(dataType, "clob") ||
+		strings.Contains(dataType, "text") ||
+		strings.Contains(dataType, "binary"):
+		return dataType, nums[0], 0, 0
+	}
+	return dataType, 0, nums[0], 0
+}
+
+// fillIndexesSQLite adds the indexes and UNIQUE constraints of the tables in
+// the supplied SQLite schema to the `Meta`'s Tables. SQLite implements UNIQUE
+// constraints with automatically created indexes.
+func fillIndexesSQLite(
+	db *sql.DB,
+	f *schemaFilter,
+	schema string,
+) error {
+	rows, err := db.Query(
+		sqliteQuery(selIndexesSQLite, schema), schema, schema,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var t *meta.Table
+	var idx *meta.Index
+	var tname, origin string
+	// flush adds the UNIQUE constraint backed by the index accumulated from
+	// the previous rows, if any, to its table
+	flush := func() {
+		if t != nil && idx != nil && origin == "u" {
+			t.AddUniqueConstraint(idx.Name, idx.Columns...)
+		}
+	}
+	for rows.Next() {
+		var rtname, iname, rorigin, colname string
+		var unique, partial bool
+		err = rows.Scan(
+			&rtname, &iname, &unique, &rorigin, &partial, &colname,
+		)
+		if err != nil {
+			return err
+		}
+		if idx == nil || rtname != tname || iname != idx.Name {
+			flush()
+			t = f.table(schema, rtname)
+			tname, origin = rtname, rorigin
+			idx = &meta.Index{
+				Name:    iname,
+				Unique:  unique,
+				Primary: rorigin == "pk",
+				Partial: partial,
+			}
+			if t != nil {
+				t.AddIndex(idx)
+			}
+		}
+		// An expression key part has no column name
+		if colname == "" {
+			idx.Expression = true
+		}
+		idx.Columns = append(idx.Columns, colname)
+		idx.Expressions = append(idx.Expressions, "")
+	}
+	flush()
+	return rows.Err()
+}
+
+// fillForeignKeysSQLite adds the FOREIGN KEY constraints of the tables in the
+// supplied SQLite schema to the `Meta`'s Tables.
+//
+// SQLite does not report the names of foreign key constraints, so each
+// foreign key is named after the referencing table and the constraint's
+// ordinal, e.g. "articles_fk_0". A foreign key that omits the referenced
+// columns refers to the referenced table's primary key.
+func fillForeignKeysSQLite(
+	db *sql.DB,
+	f *schemaFilter,
+	schema string,
+) error {
+	rows, err := db.Query(
+		sqliteQuery(selForeignKeysSQLite, schema), schema,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var fk *meta.ForeignKey
+	var tname string
+	var fkID int
+	implicit := []*meta.ForeignKey{}
+	for rows.Next() {
+		var rtname, reftname, colname string
+		var rfkID int
+		var refcolname sql.NullString
+		err = rows.Scan(&rtname, &rfkID, &reftname, &colname, &refcolname)
+		if err != nil {
+			return err
+		}
+		if fk == nil || rtname != tname || rfkID != fkID {
+			tname, fkID = rtname, rfkID
+			fk = &meta.ForeignKey{
+				Name:            fmt.Sprintf("%s_fk_%d", rtname, rfkID),
+				ReferencedTable: reftname,
+			}
+			if f.qualified() {
+				fk.ReferencedSchema = schema
+			}
+			if t := f.table(schema, rtname); t != nil {
+				t.AddForeignKey(fk)
+			}
+			if !refcolname.Valid {
+				implicit = append(implicit, fk)
+			}
+		}
+		fk.Columns = append(fk.Columns, colname)
+		if refcolname.Valid {
+			fk.ReferencedColumns = append(fk.ReferencedColumns, refcolname.String)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	for _, fk := range implicit {
+		ref := f.table(schema, fk.ReferencedTable)
+		if ref == nil || ref.PrimaryKey() == nil {
+			continue
+		}
+		fk.ReferencedColumns = slices.Clone(ref.PrimaryKey().Columns)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package reflect
+
+import (
+	"database/sql"
+
+	"github.com/jaypipes/sqlb/core/dialect"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// Version returns the version string of the RDBMS given a sql.DB handle, or an
+// empty string if the version cannot be determined. The returned string can
+// be passed to types.WithDialectVersion.
+func Version(
+	db *sql.DB,
+	mods ...types.Option,
+) string {
+	opts := types.MergeOptions(mods)
+	var d types.Dialect
+	if !opts.HasDialect() {
+		d = Dialect(db)
+	} else {
+		d = opts.Dialect()
+	}
+	r := dialect.Get(d).Reflection()
+	if r == nil || r.Version == "" {
+		return ""
+	}
+	var version string
+	if err := db.QueryRow(r.Version).Scan(&version); err != nil {
+		return ""
+	}
+	return version
+}
@@ -0,0 +1,222 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package scan
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// TagName is the struct tag key used to override the column name that a
+// struct field is mapped to. A tag value of "-" causes the field to be
+// ignored.
+const TagName = "db"
+
+var (
+	fieldMapCache sync.Map // map[reflect.Type]*fieldMapping
+	scannerType   = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// fieldMapping maps the column names of a struct type to the struct's fields
+type fieldMapping struct {
+	// fields holds, keyed by lowercased column name, the index sequence of
+	// the struct field that the column is scanned into
+	fields map[string][]int
+	// ambiguous holds, keyed by lowercased column name, the index sequences
+	// of the fields at the same depth that all map to the column name
+	ambiguous map[string][][]int
+}
+
+// fieldMap returns the mapping of column names to fields for the supplied
+// struct type.
+func fieldMap(t reflect.Type) *fieldMapping {
+	if fm, ok := fieldMapCache.Load(t); ok {
+		return fm.(*fieldMapping)
+	}
+	fm := &fieldMapping{
+		fields:    map[string][]int{},
+		ambiguous: map[string][][]int{},
+	}
+	collectFields(t, nil, fm)
+	for name := range fm.ambiguous {
+		// Two fields at the same depth map to the same column name, which
+		// means the column is ambiguous, like ambiguous promoted fields in
+		// Go itself.
+		delete(fm.fields, name)
+	}
+	fieldMapCache.Store(t, fm)
+	return fm
+}
+
+// ambiguousError returns an error wrapping types.AmbiguousColumn that names
+// the fields of the supplied struct type that the supplied column matches
+func (fm *fieldMapping) ambiguousError(t reflect.Type, col string) error {
+	idxs := fm.ambiguous[strings.ToLower(col)]
+	names := make([]string, len(idxs))
+	for x, idx := range idxs {
+		names[x] = fieldPath(t, idx)
+	}
+	return fmt.Errorf(
+		"%w: column '%s' matches fields %s of %s. use a `db` struct tag "+
+			"or a column alias to tell them apart",
+		types.AmbiguousColumn, col, strings.Join(names, " and "), t,
+	)
+}
+
+// fieldPath returns the dotted path of the field of the supplied struct type
+// with the supplied index sequence, e.g. "Article.ID"
+func fieldPath(t reflect.Type, idx []int) string {
+	names := make([]string, len(idx))
+	for x, i := range idx {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		f := t.Field(i)
+		names[x] = f.Name
+		t = f.Type
+	}
+	return strings.Join(names, ".")
+}
+
+// Values returns the values of the fields of the supplied struct, or pointer
+// to a struct, keyed by the lowercased column name that each field is mapped
+// to, using the same mapping of fields to columns as Row. Fields promoted from
+// nil embedded struct pointers are omitted. If src is not a struct or a
+// non-nil pointer to a struct, an error wrapping types.InvalidBindSource is
+// returned.
+func Values(src any) (map[string]any, error) {
+	v := reflect.ValueOf(src)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: got %T", types.InvalidBindSource, src)
+	}
+	fm := fieldMap(v.Type()).fields
+	vals := make(map[string]any, len(fm))
+	for name, idx := range fm {
+		if f, ok := fieldValue(v, idx); ok {
+			vals[name] = f.Interface()
+		}
+	}
+	return vals, nil
+}
+
+// fieldValue returns the nested field of the supplied struct Value
+// corresponding to the supplied index sequence, or false if the field is
+// promoted from a nil embedded struct pointer.
+func fieldValue(v reflect.Value, idx []int) (reflect.Value, bool) {
+	for x, i := range idx {
+		if x > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
+// collectFields adds the fields of the supplied struct type to the supplied
+// field map, recursing into embedded structs. Fields of shallower depth take
+// precedence over fields promoted from embedded structs.
+func collectFields(
+	t reflect.Type,
+	index []int,
+	fm *fieldMapping,
+) {
+	depth := len(index)
+	for x := 0; x < t.NumField(); x++ {
+		f := t.Field(x)
+		tag := f.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+		fidx := make([]int, depth+1)
+		copy(fidx, index)
+		fidx[depth] = x
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && tag == "" && isFlattenable(ft) {
+			// Unexported embedded pointers cannot be allocated when scanning,
+			// so we can only recurse into exported or non-pointer embeds.
+			if f.IsExported() || f.Type.Kind() != reflect.Pointer {
+				collectFields(ft, fidx, fm)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		name := tag
+		if name == "" {
+			name = snakeCase(f.Name)
+		}
+		name = strings.ToLower(name)
+		if existing, ok := fm.fields[name]; ok {
+			if len(existing)-1 < depth {
+				continue
+			}
+			if len(existing)-1 == depth {
+				if _, found := fm.ambiguous[name]; !found {
+					fm.ambiguous[name] = [][]int{existing}
+				}
+				fm.ambiguous[name] = append(fm.ambiguous[name], fidx)
+				continue
+			}
+			delete(fm.ambiguous, name)
+		}
+		fm.fields[name] = fidx
+	}
+}
+
+// isFlattenable returns true if the supplied type is a struct whose fields
+// should be promoted into the enclosing struct's fields, i.e. it is not
+// itself something that `database/sql` knows how to scan into.
+func isFlattenable(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if t.Implements(scannerType) || reflect.PointerTo(t).Implements(scannerType) {
+		return false
+	}
+	return t.PkgPath() != "time"
+}
+
+// snakeCase returns the supplied Go identifier converted to snake case, e.g.
+// "CreatedOn" becomes "created_on" and "UserID" becomes "user_id".
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s) + 4)
+	for x, r := range runes {
+		if unicode.IsUpper(r) {
+			if x > 0 {
+				prev := runes[x-1]
+				nextLower := x+1 < len(runes) && unicode.IsLower(runes[x+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+					(unicode.IsUpper(prev) && nextLower) {
+					b.WriteByte('_')
+				}
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,193 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Package scan maps the rows of a SQL query result onto Go structs.
+//
+// Each column in the result is matched, case-insensitively, to a struct field
+// by name. The column name is the name or alias of the Projection in the
+// Selection that produced the result, e.g. the column produced by
+// `users.C("name").As("author")` is named "author". A struct field matches a
+// column if the field's `db` struct tag equals the column name or, when the
+// field has no `db` tag, if the snake case form of the field name equals the
+// column name ("CreatedOn" matches "created_on"). Fields tagged `db:"-"` are
+// ignored.
+//
+// Fields of embedded structs are promoted into the enclosing struct, which
+// allows a struct for a joined table to be embedded in a struct representing
+// the joined result. As with Go's own promoted fields, a field in the
+// enclosing struct hides a field of the same name in an embedded struct.
+// Scanning a column that matches fields of the same name in two embedded
+// structs, e.g. the "id" column of two joined tables, fails with an error
+// wrapping types.AmbiguousColumn. Alias one of the columns, e.g.
+// `users.C("id").As("user_id")`, and tag the corresponding field with
+// `db:"user_id"` to scan both.
+//
+// Fields may be any type that `database/sql` can scan into, including
+// `sql.Null*` types, `sql.Scanner` implementations and pointers, which are
+// set to nil when the column value is NULL.
+package scan
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// Row scans the current row of the supplied `*sql.Rows` into the struct
+// pointed to by dest. If dest is not a non-nil pointer to a struct, an error
+// wrapping types.InvalidScanDestination is returned. If any column in the
+// result has no matching field in the struct, an error wrapping
+// types.UnmatchedColumn that names the column is returned. If a column
+// matches more than one field at the same depth of embedding, an error
+// wrapping types.AmbiguousColumn that names the fields is returned.
+func Row(rows *sql.Rows, dest any) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Pointer || v.IsNil() ||
+		v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf(
+			"%w: got %T", types.InvalidScanDestination, dest,
+		)
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	targets, err := targetsFor(v.Elem(), cols)
+	if err != nil {
+		return err
+	}
+	return rows.Scan(targets...)
+}
+
+// All scans all remaining rows of the supplied `*sql.Rows` into a slice of T,
+// which must be a struct type or a pointer to a struct type. The supplied
+// rows are not closed.
+func All[T any](rows *sql.Rows) ([]T, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	res := []T{}
+	for rows.Next() {
+		var item T
+		v, err := structValue(&item)
+		if err != nil {
+			return nil, err
+		}
+		targets, err := targetsFor(v, cols)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(targets...); err != nil {
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// One scans the first row of the supplied `*sql.Rows` into a T, which must be
+// a struct type or a pointer to a struct type. If there are no rows,
+// `sql.ErrNoRows` is returned. Any rows after the first are ignored and the
+// supplied rows are not closed.
+func One[T any](rows *sql.Rows) (T, error) {
+	var item T
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return item, err
+		}
+		return item, sql.ErrNoRows
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return item, err
+	}
+	v, err := structValue(&item)
+	if err != nil {
+		return item, err
+	}
+	targets, err := targetsFor(v, cols)
+	if err != nil {
+		return item, err
+	}
+	if err := rows.Scan(targets...); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// structValue returns the addressable struct Value for the supplied pointer
+// to a struct or pointer to a pointer to a struct, allocating the struct in
+// the latter case.
+func structValue(ptr any) (reflect.Value, error) {
+	v := reflect.ValueOf(ptr).Elem()
+	if v.Kind() == reflect.Pointer {
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf(
+				"%w: got %s", types.InvalidScanDestination, v.Type(),
+			)
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf(
+			"%w: got %s", types.InvalidScanDestination, v.Type(),
+		)
+	}
+	return v, nil
+}
+
+// targetsFor returns a slice of pointers to the fields of the supplied
+// struct Value that each of the supplied column names should be scanned into.
+func targetsFor(v reflect.Value, cols []string) ([]any, error) {
+	fm := fieldMap(v.Type())
+	targets := make([]any, len(cols))
+	for x, col := range cols {
+		name := strings.ToLower(col)
+		idx, ok := fm.fields[name]
+		if !ok {
+			// Some drivers return qualified column names
+			if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+				name = name[dot+1:]
+				idx, ok = fm.fields[name]
+			}
+		}
+		if !ok {
+			if _, found := fm.ambiguous[name]; found {
+				return nil, fm.ambiguousError(v.Type(), name)
+			}
+			return nil, fmt.Errorf(
+				"%w: column '%s' has no matching field in %s",
+				types.UnmatchedColumn, col, v.Type(),
+			)
+		}
+		targets[x] = fieldByIndex(v, idx).Addr().Interface()
+	}
+	return targets, nil
+}
+
+// fieldByIndex returns the nested field of the supplied struct Value
+// corresponding to the supplied index sequence, allocating any nil embedded
+// struct pointers along the way.
+func fieldByIndex(v reflect.Value, idx []int) reflect.Value {
+	for x, i := range idx {
+		if x > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
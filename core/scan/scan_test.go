@@ -0,0 +1,190 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package scan_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaypipes/sqlb/core/scan"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type User struct {
+	ID        int64
+	Name      string
+	CreatedOn time.Time
+}
+
+type Article struct {
+	ID     int64 `db:"article_id"`
+	Title  string
+	Author *string
+	State  sql.NullInt64
+	Ignore string `db:"-"`
+}
+
+type articleWithAuthor struct {
+	Article
+	*User
+	// hides the ID fields of the embedded structs
+	ID string
+}
+
+func query(t *testing.T, res testutil.FakeResult) *sql.Rows {
+	db := testutil.NewFakeDB(&testutil.FakeDriver{Result: res})
+	rows, err := db.QueryContext(context.TODO(), "SELECT")
+	require.Nil(t, err)
+	t.Cleanup(func() { rows.Close() })
+	return rows
+}
+
+func TestAll(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+
+	rows := query(t, testutil.FakeResult{
+		Columns: []string{"id", "NAME", "created_on"},
+		Rows: [][]driver.Value{
+			{int64(1), "foo", now},
+			{int64(2), "bar", now},
+		},
+	})
+	got, err := scan.All[User](rows)
+	require.Nil(t, err)
+	assert.Equal([]User{
+		{ID: 1, Name: "foo", CreatedOn: now},
+		{ID: 2, Name: "bar", CreatedOn: now},
+	}, got)
+}
+
+func TestAllPointers(t *testing.T) {
+	assert := assert.New(t)
+
+	rows := query(t, testutil.FakeResult{
+		Columns: []string{"article_id", "title", "author", "state"},
+		Rows: [][]driver.Value{
+			{int64(1), "foo", "alice", int64(2)},
+			{int64(2), "bar", nil, nil},
+		},
+	})
+	got, err := scan.All[*Article](rows)
+	require.Nil(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(int64(1), got[0].ID)
+	require.NotNil(t, got[0].Author)
+	assert.Equal("alice", *got[0].Author)
+	assert.Equal(sql.NullInt64{Int64: 2, Valid: true}, got[0].State)
+	assert.Nil(got[1].Author)
+	assert.False(got[1].State.Valid)
+}
+
+func TestOneEmbedded(t *testing.T) {
+	assert := assert.New(t)
+
+	rows := query(t, testutil.FakeResult{
+		Columns: []string{"id", "article_id", "title", "users.name"},
+		Rows: [][]driver.Value{
+			{"x1", int64(1), "foo", "alice"},
+		},
+	})
+	got, err := scan.One[articleWithAuthor](rows)
+	require.Nil(t, err)
+	assert.Equal("x1", got.ID)
+	assert.Equal(int64(1), got.Article.ID)
+	assert.Equal("foo", got.Title)
+	require.NotNil(t, got.User)
+	assert.Equal("alice", got.Name)
+}
+
+func TestOneNoRows(t *testing.T) {
+	rows := query(t, testutil.FakeResult{Columns: []string{"id"}})
+	_, err := scan.One[User](rows)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestRow(t *testing.T) {
+	assert := assert.New(t)
+
+	rows := query(t, testutil.FakeResult{
+		Columns: []string{"id", "name"},
+		Rows:    [][]driver.Value{{int64(1), "foo"}},
+	})
+	require.True(t, rows.Next())
+
+	var u User
+	err := scan.Row(rows, u)
+	assert.True(errors.Is(err, types.InvalidScanDestination))
+
+	err = scan.Row(rows, &u)
+	require.Nil(t, err)
+	assert.Equal(User{ID: 1, Name: "foo"}, u)
+}
+
+func TestUnmatchedColumn(t *testing.T) {
+	rows := query(t, testutil.FakeResult{
+		Columns: []string{"id", "unknown"},
+		Rows:    [][]driver.Value{{int64(1), "foo"}},
+	})
+	_, err := scan.All[User](rows)
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, types.UnmatchedColumn))
+	assert.Contains(t, err.Error(), "'unknown'")
+}
+
+type Comment struct {
+	ID   int64
+	Body string
+}
+
+// commentWithUser embeds two structs that both have an ID field
+type commentWithUser struct {
+	Comment
+	*User
+}
+
+func TestAmbiguousColumn(t *testing.T) {
+	assert := assert.New(t)
+
+	// The "id" column of a join of the two tables matches the ID fields of
+	// both embedded structs, so the error names both candidates
+	rows := query(t, testutil.FakeResult{
+		Columns: []string{"body", "id"},
+		Rows:    [][]driver.Value{{"hi", int64(1)}},
+	})
+	_, err := scan.All[commentWithUser](rows)
+	require.NotNil(t, err)
+	assert.True(errors.Is(err, types.AmbiguousColumn))
+	assert.Contains(err.Error(), "'id'")
+	assert.Contains(err.Error(), "Comment.ID and User.ID")
+
+	// Qualified column names are matched by their last part
+	rows = query(t, testutil.FakeResult{
+		Columns: []string{"comments.id"},
+		Rows:    [][]driver.Value{{int64(1)}},
+	})
+	_, err = scan.All[commentWithUser](rows)
+	assert.True(errors.Is(err, types.AmbiguousColumn))
+
+	// Columns that only one of the embedded structs has are unambiguous
+	rows = query(t, testutil.FakeResult{
+		Columns: []string{"body", "name"},
+		Rows:    [][]driver.Value{{"hi", "alice"}},
+	})
+	got, err := scan.One[commentWithUser](rows)
+	require.Nil(t, err)
+	assert.Equal("hi", got.Body)
+	require.NotNil(t, got.User)
+	assert.Equal("alice", got.Name)
+}
@@ -0,0 +1,16 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+import "github.com/jaypipes/sqlb/core/grammar"
+
+// DeleteStatementSearchedConverter knows how to convert itself into a
+// `*grammar.DeleteStatementSearched`
+type DeleteStatementSearchedConverter interface {
+	// DeleteStatementSearched returns the object as a `*grammar.DeleteStatementSearched`
+	DeleteStatementSearched() *grammar.DeleteStatementSearched
+}
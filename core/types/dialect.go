@@ -6,7 +6,8 @@
 
 package types
 
-// Dialect is the SQL variant of the underlying RDBMS
+// Dialect is the SQL variant of the underlying RDBMS. The behavior of each
+// Dialect is described by the `core/dialect` Dialect registered for it.
 type Dialect int
 
 const (
@@ -16,3 +17,7 @@ const (
 	DialectTSQL
 	DialectSQLite
 )
+
+// DialectCustom is the first Dialect identifier available to custom dialects
+// registered with `core/dialect.Register`
+const DialectCustom Dialect = 1000
@@ -16,7 +16,62 @@ var (
 	NoTargetTable            = errors.New("No target table supplied.")
 	NoValues                 = errors.New("No values supplied.")
 	UnknownColumn            = errors.New("Received an unknown column.")
+	// UnmatchedColumn is returned when scanning a result row into a struct
+	// and a column in the result has no matching struct field
+	UnmatchedColumn = errors.New("Result column has no matching struct field.")
+	// AmbiguousColumn is returned when scanning a result row into a struct
+	// and a column in the result matches more than one struct field at the
+	// same depth of embedding
+	AmbiguousColumn = errors.New("Result column matches more than one struct field.")
+	// InvalidScanDestination is returned when the destination passed to a
+	// scanning function is not a non-nil pointer to a struct
+	InvalidScanDestination = errors.New("Scan destination must be a non-nil pointer to a struct.")
+	// NoForeignKey is returned when a join condition cannot be inferred
+	// because there is no foreign key between the tables being joined
+	NoForeignKey = errors.New("No foreign key relationship found.")
+	// AmbiguousForeignKey is returned when a join condition cannot be
+	// inferred because there is more than one foreign key between the tables
+	// being joined
+	AmbiguousForeignKey = errors.New("More than one foreign key relationship found.")
+	// ReadOnlyRelation is returned when attempting to construct an INSERT,
+	// UPDATE or DELETE statement against a view that is not updatable
+	ReadOnlyRelation = errors.New("Relation is read-only.")
 	// TableRequired is returned when calling a sqlb function that requires a
 	// types.Table
 	TableRequired = errors.New("required *sqlb.Table argument is nil")
+	// ReflectionNotSupported is returned by Reflect when the Dialect of the
+	// database connection does not support reflection, e.g. because the
+	// database driver is not mapped to a Dialect
+	ReflectionNotSupported = errors.New("Reflection is not supported for the dialect.")
+	// FunctionNotSupported is returned when building a SQL function, or a
+	// form of a SQL function, that the Dialect has no equivalent of
+	FunctionNotSupported = errors.New("Function is not supported by the dialect.")
+	// FeatureNotSupported is returned when building a clause or statement,
+	// e.g. a RETURNING clause, that the Dialect has no equivalent of
+	FeatureNotSupported = errors.New("Feature is not supported by the dialect.")
+	// UnsupportedBuildTarget is returned by Build when the supplied target is
+	// not a statement, query or relation that sqlb knows how to build
+	UnsupportedBuildTarget = errors.New("Unable to build target. Target is not a statement, query or relation.")
+	// UnknownRelationReference is returned by Build when a column reference
+	// is qualified with the name of a relation that is not in scope, e.g. a
+	// table that is not in the FROM clause
+	UnknownRelationReference = errors.New("Column reference refers to a relation that is not in scope.")
+	// EmptyInList is returned by Build when an IN predicate has no values
+	EmptyInList = errors.New("IN predicate has no values.")
+	// LimitWithoutOrderBy is returned by Build when a query has a LIMIT
+	// clause with an offset but without an ORDER BY clause in a Dialect,
+	// such as T-SQL, whose row offsets are only defined for ordered results
+	LimitWithoutOrderBy = errors.New("LIMIT with an offset requires an ORDER BY clause in the dialect.")
+	// UnsupportedInlineArg is returned when a query arg cannot be output as
+	// a SQL literal because of its type or value
+	UnsupportedInlineArg = errors.New("Query arg cannot be output as a SQL literal.")
+	// MissingParam is returned when no value is supplied for a named
+	// parameter of a Template
+	MissingParam = errors.New("No value supplied for named parameter.")
+	// UnknownParam is returned when a value is supplied for a named
+	// parameter that a Template does not have
+	UnknownParam = errors.New("Value supplied for unknown named parameter.")
+	// InvalidBindSource is returned when the source of a Template's
+	// parameter values is not a struct or a non-nil pointer to a struct
+	InvalidBindSource = errors.New("Bind source must be a struct or a non-nil pointer to a struct.")
 )
@@ -0,0 +1,31 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Executor is something that can execute SQL statements. It is implemented by
+// `*sql.DB`, `*sql.Tx` and `*sql.Conn`, allowing SQL statements to be executed
+// against a database handle, within a transaction or on a single connection.
+type Executor interface {
+	// ExecContext executes a SQL statement without returning any rows
+	ExecContext(context.Context, string, ...any) (sql.Result, error)
+	// QueryContext executes a SQL query that returns rows
+	QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	// QueryRowContext executes a SQL query that is expected to return at
+	// most one row
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}
+
+var (
+	_ Executor = (*sql.DB)(nil)
+	_ Executor = (*sql.Tx)(nil)
+	_ Executor = (*sql.Conn)(nil)
+)
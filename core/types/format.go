@@ -9,4 +9,24 @@ package types
 type FormatOptions struct {
 	SeparateClauseWith string
 	PrefixWith         string
+	// Pretty puts each clause, select-list item, join, predicate conjunct and
+	// subquery on its own line, indented with Indent for each level of
+	// nesting. SeparateClauseWith is ignored when Pretty is true.
+	Pretty bool
+	Indent string
+	// KeywordCase determines the case of SQL keywords
+	KeywordCase KeywordCase
 }
+
+// KeywordCase determines the case that SQL keywords are output in
+type KeywordCase int
+
+const (
+	// KeywordCaseDefault outputs SQL keywords as sqlb and the Dialect spell
+	// them, which is upper case for all built-in Dialects
+	KeywordCaseDefault KeywordCase = iota
+	// KeywordCaseUpper outputs SQL keywords in upper case
+	KeywordCaseUpper
+	// KeywordCaseLower outputs SQL keywords in lower case
+	KeywordCaseLower
+)
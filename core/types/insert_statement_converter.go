@@ -0,0 +1,16 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+import "github.com/jaypipes/sqlb/core/grammar"
+
+// InsertStatementConverter knows how to convert itself into a
+// `*grammar.InsertStatement`
+type InsertStatementConverter interface {
+	// InsertStatement returns the object as a `*grammar.InsertStatement`
+	InsertStatement() *grammar.InsertStatement
+}
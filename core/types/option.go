@@ -8,7 +8,18 @@ package types
 
 type Options struct {
 	dialect *Dialect
-	format  *FormatOptions
+	// dialectVersion is the version of the RDBMS, e.g. "3.38.5"
+	dialectVersion string
+	format         *FormatOptions
+	// schemas are the names of the schemas to reflect
+	schemas []string
+	// allSchemas indicates that all non-system schemas should be reflected
+	allSchemas bool
+	// quoteMode determines when identifiers are delimited
+	quoteMode QuoteMode
+	// inlineArgs indicates that query args should be output as SQL literals
+	// instead of placeholders
+	inlineArgs bool
 }
 
 // HasDialect returns true if the Options' Dialect has been set
@@ -24,6 +35,23 @@ func (o *Options) Dialect() Dialect {
 	return *o.dialect
 }
 
+// DialectVersion returns the version of the RDBMS, or an empty string if not
+// set
+func (o *Options) DialectVersion() string {
+	if o == nil {
+		return ""
+	}
+	return o.dialectVersion
+}
+
+// DialectVersionAtLeast returns true if the Options' DialectVersion is greater
+// than or equal to the supplied major and minor version numbers. If the
+// DialectVersion is not set or cannot be parsed, the RDBMS is assumed to be a
+// recent version and true is returned.
+func (o *Options) DialectVersionAtLeast(major int, minor int) bool {
+	return VersionAtLeast(o.DialectVersion(), major, minor)
+}
+
 // FormatPrefixWith returns the Options' FormatPrefixWith or the default if not
 // set
 func (o *Options) FormatPrefixWith() string {
@@ -42,6 +70,56 @@ func (o *Options) FormatSeparateClauseWith() string {
 	return (*o.format).SeparateClauseWith
 }
 
+// FormatPretty returns true if the Options' FormatPretty has been set
+func (o *Options) FormatPretty() bool {
+	return o != nil && o.format != nil && o.format.Pretty
+}
+
+// FormatIndent returns the string used to indent each level of nesting when
+// pretty printing
+func (o *Options) FormatIndent() string {
+	if o == nil || o.format == nil {
+		return DefaultFormatOptions.Indent
+	}
+	return (*o.format).Indent
+}
+
+// FormatKeywordCase returns the Options' KeywordCase, which defaults to
+// KeywordCaseDefault
+func (o *Options) FormatKeywordCase() KeywordCase {
+	if o == nil || o.format == nil {
+		return DefaultFormatOptions.KeywordCase
+	}
+	return (*o.format).KeywordCase
+}
+
+// Schemas returns the names of the schemas that should be reflected, if any
+func (o *Options) Schemas() []string {
+	if o == nil {
+		return nil
+	}
+	return o.schemas
+}
+
+// AllSchemas returns true if all non-system schemas should be reflected
+func (o *Options) AllSchemas() bool {
+	return o != nil && o.allSchemas
+}
+
+// QuoteMode returns the Options' QuoteMode, which defaults to QuoteWhenNeeded
+func (o *Options) QuoteMode() QuoteMode {
+	if o == nil {
+		return QuoteWhenNeeded
+	}
+	return o.quoteMode
+}
+
+// InlineArgs returns true if query args should be output as SQL literals
+// instead of placeholders
+func (o *Options) InlineArgs() bool {
+	return o != nil && o.inlineArgs
+}
+
 // Option modifies an Options
 type Option func(o *Options)
 
@@ -63,16 +141,23 @@ func WithDialect(
 	}
 }
 
+// WithDialectVersion informs sqlb of the version of the RDBMS, e.g. "3.38.5",
+// allowing sqlb to avoid SQL syntax that the version does not support
+func WithDialectVersion(
+	version string,
+) Option {
+	return func(o *Options) {
+		o.dialectVersion = version
+	}
+}
+
 // WithFormatSeparateClauseWith instructs sqlb to use a supplied string as the
 // separator between clauses
 func WithFormatSeparateClauseWith(
 	with string,
 ) Option {
 	return func(o *Options) {
-		if o.format == nil {
-			o.format = &FormatOptions{}
-		}
-		o.format.SeparateClauseWith = with
+		o.formatOptions().SeparateClauseWith = with
 	}
 }
 
@@ -82,9 +167,82 @@ func WithFormatPrefixWith(
 	with string,
 ) Option {
 	return func(o *Options) {
-		if o.format == nil {
-			o.format = &FormatOptions{}
-		}
-		o.format.PrefixWith = with
+		o.formatOptions().PrefixWith = with
+	}
+}
+
+// WithFormatPretty instructs sqlb to put each clause, select-list item, join,
+// predicate conjunct and subquery on its own line, indenting each level of
+// nesting with the supplied string, e.g. "  " or "\t"
+func WithFormatPretty(
+	indent string,
+) Option {
+	return func(o *Options) {
+		f := o.formatOptions()
+		f.Pretty = true
+		f.Indent = indent
+	}
+}
+
+// WithFormatKeywordCase instructs sqlb to output SQL keywords in the supplied
+// case
+func WithFormatKeywordCase(
+	kc KeywordCase,
+) Option {
+	return func(o *Options) {
+		o.formatOptions().KeywordCase = kc
+	}
+}
+
+// formatOptions returns the Options' FormatOptions, initializing them from
+// DefaultFormatOptions if not yet set
+func (o *Options) formatOptions() *FormatOptions {
+	if o.format == nil {
+		f := DefaultFormatOptions
+		o.format = &f
+	}
+	return o.format
+}
+
+// WithSchemas instructs sqlb to reflect the tables in the supplied schemas
+// instead of only the tables in the default schema. Tables reflected from
+// the supplied schemas have their names qualified with the schema name in SQL
+// statements.
+func WithSchemas(
+	schemas ...string,
+) Option {
+	return func(o *Options) {
+		o.schemas = append(o.schemas, schemas...)
+	}
+}
+
+// WithAllSchemas instructs sqlb to reflect the tables in all schemas except
+// the RDBMS's own system schemas. Tables reflected this way have their names
+// qualified with the schema name in SQL statements.
+func WithAllSchemas() Option {
+	return func(o *Options) {
+		o.allSchemas = true
+	}
+}
+
+// WithQuoteMode instructs sqlb when to delimit identifiers with the Dialect's
+// quote characters. The default is QuoteWhenNeeded.
+func WithQuoteMode(
+	mode QuoteMode,
+) Option {
+	return func(o *Options) {
+		o.quoteMode = mode
+	}
+}
+
+// WithInlineArgs instructs sqlb to output query args as SQL literals in place
+// of their placeholders and to return no query args.
+//
+// WithInlineArgs is for debugging and logging only. SQL built with it must
+// never be executed, since escaping values into SQL literals is not a
+// substitute for passing query args to the database driver.
+func WithInlineArgs() Option {
+	return func(o *Options) {
+		o.inlineArgs = true
 	}
 }
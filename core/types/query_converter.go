@@ -0,0 +1,16 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+// QueryConverter knows how to convert itself into a SELECT query, which is a
+// `*grammar.CursorSpecification` if the query has an ORDER BY or LIMIT clause
+// and a `*grammar.QuerySpecification` otherwise
+type QueryConverter interface {
+	// Query returns the object as a `*grammar.CursorSpecification` or
+	// `*grammar.QuerySpecification`
+	Query() interface{}
+}
@@ -0,0 +1,25 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+// QuoteMode determines when identifiers are delimited with the Dialect's
+// quote characters: backticks for MySQL, square brackets for T-SQL and double
+// quotes for PostgreSQL, SQLite and ANSI SQL.
+type QuoteMode int
+
+const (
+	// QuoteWhenNeeded delimits identifiers that are reserved words in ANSI
+	// SQL or the Dialect, that contain characters other than letters, digits
+	// and underscores or that begin with a digit. For PostgreSQL, identifiers
+	// containing upper-case letters are also delimited, since PostgreSQL folds
+	// undelimited identifiers to lower case.
+	QuoteWhenNeeded QuoteMode = iota
+	// QuoteAlways delimits all identifiers
+	QuoteAlways
+	// QuoteNever never delimits identifiers
+	QuoteNever
+)
@@ -0,0 +1,16 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+import "github.com/jaypipes/sqlb/core/grammar"
+
+// UpdateStatementSearchedConverter knows how to convert itself into a
+// `*grammar.UpdateStatementSearched`
+type UpdateStatementSearchedConverter interface {
+	// UpdateStatementSearched returns the object as a `*grammar.UpdateStatementSearched`
+	UpdateStatementSearched() *grammar.UpdateStatementSearched
+}
@@ -0,0 +1,70 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package types
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VersionAtLeast returns true if the supplied RDBMS version string, e.g.
+// "3.38.5", is greater than or equal to the supplied major and minor version
+// numbers. If the version is empty or cannot be parsed, the RDBMS is assumed
+// to be a recent version and true is returned.
+func VersionAtLeast(version string, major int, minor int) bool {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	vmajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	vminor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return true
+	}
+	if vmajor != major {
+		return vmajor > major
+	}
+	return vminor >= minor
+}
+
+// VersionAtLeastPatch returns true if the supplied RDBMS version string, e.g.
+// "8.0.13-log", is greater than or equal to the supplied major, minor and
+// patch version numbers. Any suffix of the patch version, like "-log", is
+// ignored. If the version is empty or cannot be parsed, the RDBMS is assumed
+// to be a recent version and true is returned.
+func VersionAtLeastPatch(
+	version string,
+	major int,
+	minor int,
+	patch int,
+) bool {
+	if !VersionAtLeast(version, major, minor) {
+		return false
+	}
+	// A later major or minor version is newer whatever its patch version
+	if VersionAtLeast(version, major, minor+1) {
+		return true
+	}
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 3 {
+		return true
+	}
+	digits := parts[2]
+	if n := strings.IndexFunc(digits, func(r rune) bool {
+		return r < '0' || r > '9'
+	}); n >= 0 {
+		digits = digits[:n]
+	}
+	vpatch, err := strconv.Atoi(digits)
+	if err != nil {
+		return true
+	}
+	return vpatch >= patch
+}
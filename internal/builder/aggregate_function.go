@@ -13,30 +13,26 @@ import (
 
 func (b *Builder) doAggregateFunction(
 	el *grammar.AggregateFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.CountStar != nil {
-		b.WriteString(symbol.Count)
+		b.doKeyword(symbol.Count)
 		b.WriteString(symbol.LeftParen)
 		b.WriteString(symbol.Asterisk)
 		b.WriteString(symbol.RightParen)
 	} else if el.GeneralSet != nil {
-		b.doGeneralSetFunction(el.GeneralSet, qargs, curarg)
+		b.doGeneralSetFunction(el.GeneralSet)
 	}
 }
 
 func (b *Builder) doGeneralSetFunction(
 	el *grammar.GeneralSetFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(grammar.ComputationalOperationSymbol[el.Operation])
+	b.doFunctionName(grammar.ComputationalOperationSymbol[el.Operation])
 	b.WriteString(symbol.LeftParen)
 	if el.Quantifier == grammar.SetQuantifierDistinct {
-		b.WriteString(symbol.Distinct)
+		b.doKeyword(symbol.Distinct)
 		b.WriteString(symbol.Space)
 	}
-	b.doValueExpression(&el.Value, qargs, curarg)
+	b.doValueExpression(&el.Value)
 	b.WriteString(symbol.RightParen)
 }
@@ -13,11 +13,9 @@ import (
 
 func (b *Builder) doBooleanValueExpression(
 	el *grammar.BooleanValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Unary != nil {
-		b.doBooleanTerm(el.Unary, qargs, curarg)
+		b.doBooleanTerm(el.Unary)
 	} else if el.OrLeft != nil {
 		if el.OrRight == nil {
 			// This should not happen, so if it does, panic since it's a fault
@@ -25,71 +23,74 @@ func (b *Builder) doBooleanValueExpression(
 			panic("got nil OrRight but non-nil OrLeft")
 		}
 		b.WriteString(symbol.LeftParen)
-		b.doBooleanValueExpression(el.OrLeft, qargs, curarg)
+		b.doBooleanValueExpression(el.OrLeft)
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Or)
+		b.doKeyword(symbol.Or)
 		b.WriteString(symbol.Space)
-		b.doBooleanTerm(el.OrRight, qargs, curarg)
+		b.doBooleanTerm(el.OrRight)
 		b.WriteString(symbol.RightParen)
 	}
 }
 
 func (b *Builder) doBooleanTerm(
 	el *grammar.BooleanTerm,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Unary != nil {
-		b.doBooleanFactor(el.Unary, qargs, curarg)
+		b.doBooleanFactor(el.Unary)
 	} else if el.AndLeft != nil {
 		if el.AndRight == nil {
 			// This should not happen, so if it does, panic since it's a fault
 			// in sqlb's parsing logic.
 			panic("got nil AndRight but non-nil AndLeft")
 		}
-		b.doBooleanTerm(el.AndLeft, qargs, curarg)
-		b.WriteString(symbol.Space)
-		b.WriteString(symbol.And)
+		b.doBooleanTerm(el.AndLeft)
+		if b.opts.FormatPretty() {
+			// Each conjunct goes on its own line, indented beneath the
+			// clause it belongs to
+			b.doLineBreak(b.depth + 1)
+		} else {
+			b.WriteString(symbol.Space)
+		}
+		b.doKeyword(symbol.And)
 		b.WriteString(symbol.Space)
-		b.doBooleanFactor(el.AndRight, qargs, curarg)
+		b.doBooleanFactor(el.AndRight)
 	}
 }
 
 func (b *Builder) doBooleanFactor(
 	el *grammar.BooleanFactor,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Not {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Not)
+		b.doKeyword(symbol.Not)
 		b.WriteString(symbol.Space)
 	}
-	b.doBooleanPrimary(&el.Test.Primary, qargs, curarg)
+	b.doBooleanPrimary(&el.Test.Primary)
 }
 
 func (b *Builder) doBooleanPrimary(
 	el *grammar.BooleanPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Predicate != nil {
-		b.doPredicate(el.Predicate, qargs, curarg)
+		b.doPredicate(el.Predicate)
 	} else if el.Predicand != nil {
-		b.doBooleanPredicand(el.Predicand, qargs, curarg)
+		b.doBooleanPredicand(el.Predicand)
 	}
 }
 
 func (b *Builder) doBooleanPredicand(
 	el *grammar.BooleanPredicand,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Parenthesized != nil {
+		// OR expressions are already output surrounded by parens
+		if el.Parenthesized.OrLeft != nil {
+			b.doBooleanValueExpression(el.Parenthesized)
+			return
+		}
 		b.WriteString(symbol.LeftParen)
-		b.doBooleanValueExpression(el.Parenthesized, qargs, curarg)
+		b.doBooleanValueExpression(el.Parenthesized)
 		b.WriteString(symbol.RightParen)
 	} else if el.Primary != nil {
-		b.doNonParenthesizedValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doNonParenthesizedValueExpressionPrimary(el.Primary)
 	}
 }
@@ -7,11 +7,11 @@
 package builder
 
 import (
-	"strconv"
+	"fmt"
 	"strings"
 
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
-	"github.com/jaypipes/sqlb/core/grammar/symbol"
 	"github.com/jaypipes/sqlb/core/types"
 )
 
@@ -20,71 +20,209 @@ import (
 type Builder struct {
 	strings.Builder
 	opts types.Options
+	// dialect is the Dialect registered for the Options' dialect identifier
+	dialect dialect.Dialect
+	// top is the LIMIT clause to output as a TOP clause of the next query
+	// specification when building T-SQL
+	top *grammar.LimitClause
+	// err is the first error encountered while building, e.g. a SQL function
+	// that the dialect has no equivalent of
+	err error
+	// validate is true when the target is being built by Build, which also
+	// checks the target for SQL that would fail, or behave unpredictably,
+	// when executed
+	validate bool
+	// scopes is the stack of names of the relations that column references
+	// may refer to, innermost last. Only tracked when validating.
+	scopes [][]string
+	// lastScope is the scope most recently popped from scopes
+	lastScope []string
+	// scopeNames holds the names of all of the scopes
+	scopeNames []string
+	// depth is the level of subquery nesting used to indent lines when
+	// pretty printing
+	depth int
+	// fingerprint is true when the target is being built by Fingerprint,
+	// which outputs the normalized shape of the target
+	fingerprint bool
+	// compiling is true when the target is being built by Compile, whose
+	// Template supplies the values of named parameters
+	compiling bool
+	// args holds the query args of the placeholders output so far
+	args []interface{}
+	// placeholders caches the dialect's placeholder for each query arg
+	// position
+	placeholders []string
+}
+
+// Build returns the built query string and a slice of interface{}
+// representing the values of the query args used in the query string, if
+// any, or an error if the supplied target is not something sqlb knows how to
+// build or would not be valid SQL.
+//
+// The target may be a `*meta.Table` or other relation, a `*expr.Selection`,
+// an INSERT, UPDATE or DELETE statement, a derived table or any of the
+// `core/grammar` statement elements. In addition to the errors returned by
+// StringArgsE, Build returns an error if the target:
+//
+// * refers to a relation that is not in scope, e.g. a WHERE clause that
+// refers to a table that is not in the FROM clause
+// * contains an IN predicate with no values
+// * has a LIMIT clause with an offset but without an ORDER BY clause in a
+// dialect such as T-SQL that cannot offset unordered results
+// * contains a named parameter created with `expr.Param` and is not being
+// compiled into a Template by Compile
+func (b *Builder) Build(
+	target interface{},
+) (string, []interface{}, error) {
+	el := element(target)
+	if el == nil {
+		return "", nil, fmt.Errorf(
+			"%w: %T", types.UnsupportedBuildTarget, target,
+		)
+	}
+	b.validate = true
+	return b.StringArgsE(el)
+}
+
+// element returns the `core/grammar` statement element wrapped by the
+// supplied target, or nil if the target is not something sqlb knows how to
+// build
+func element(target interface{}) interface{} {
+	switch target := target.(type) {
+	case *grammar.CursorSpecification,
+		*grammar.QuerySpecification,
+		*grammar.InsertStatement,
+		*grammar.UpdateStatementSearched,
+		*grammar.DeleteStatementSearched,
+		*grammar.TruncateTableStatement:
+		return target
+	case types.QueryConverter:
+		return target.Query()
+	case types.InsertStatementConverter:
+		return target.InsertStatement()
+	case types.UpdateStatementSearchedConverter:
+		return target.UpdateStatementSearched()
+	case types.DeleteStatementSearchedConverter:
+		return target.DeleteStatementSearched()
+	case types.QuerySpecificationConverter:
+		return target.QuerySpecification()
+	}
+	return nil
 }
 
 // StringArgs returns the built query string and a slice of interface{}
 // representing the values of the query args used in the query string, if any.
+//
+// StringArgs panics if the supplied target cannot be output in the Builder's
+// dialect, e.g. because it uses a SQL function the dialect has no equivalent
+// of. Use StringArgsE to have the error returned instead. Like StringArgsE,
+// StringArgs returns any named parameter as a
+// `*grammar.HostParameterSpecification` query arg.
 func (b *Builder) StringArgs(target interface{}) (string, []interface{}) {
+	qs, qargs, err := b.StringArgsE(target)
+	if err != nil {
+		panic(err)
+	}
+	return qs, qargs
+}
+
+// StringArgsE returns the built query string and a slice of interface{}
+// representing the values of the query args used in the query string, if
+// any, or an error if the supplied target cannot be output in the Builder's
+// dialect.
+//
+// Unlike Build, StringArgsE does not validate the target, so a named
+// parameter created with `expr.Param` is returned as a
+// `*grammar.HostParameterSpecification` query arg.
+func (b *Builder) StringArgsE(
+	target interface{},
+) (string, []interface{}, error) {
+	qs, qargs := b.stringArgs(target)
+	if b.err != nil {
+		return "", nil, b.err
+	}
+	if b.opts.InlineArgs() {
+		return qs, []interface{}{}, nil
+	}
+	return qs, qargs, nil
+}
+
+// stringArgs outputs the supplied target to the buffer and returns the built
+// query string and query args
+func (b *Builder) stringArgs(target interface{}) (string, []interface{}) {
+	shape := shapeOf(target)
+	if shape == nil {
+		return "", []interface{}{}
+	}
+	b.Grow(int(shape.size.Load()))
+	b.args = make([]interface{}, 0, shape.args.Load())
 	b.WriteString(b.opts.FormatPrefixWith())
 	switch el := target.(type) {
 	case *grammar.UpdateStatementSearched:
-		argc := 0
-		el.ArgCount(&argc)
-		qargs := make([]interface{}, argc)
-		curarg := 0
-		b.doUpdateStatementSearched(el, qargs, &curarg)
-		return b.Builder.String(), qargs
+		b.doUpdateStatementSearched(el)
 	case *grammar.DeleteStatementSearched:
-		argc := 0
-		el.ArgCount(&argc)
-		qargs := make([]interface{}, argc)
-		curarg := 0
-		b.doDeleteStatementSearched(el, qargs, &curarg)
-		return b.Builder.String(), qargs
+		b.doDeleteStatementSearched(el)
+	case *grammar.TruncateTableStatement:
+		b.doTruncateTableStatement(el)
 	case *grammar.InsertStatement:
-		argc := len(el.Values)
-		qargs := make([]interface{}, argc)
-		curarg := 0
-		b.doInsertStatement(el, qargs, &curarg)
-		return b.Builder.String(), qargs
+		b.doInsertStatement(el)
 	case *grammar.QuerySpecification:
-		argc := 0
-		el.ArgCount(&argc)
-		qargs := make([]interface{}, argc)
-		curarg := 0
-		b.doQuerySpecification(el, qargs, &curarg)
-		return b.Builder.String(), qargs
+		b.doQuerySpecification(el)
 	case *grammar.CursorSpecification:
-		argc := 0
-		el.ArgCount(&argc)
-		qargs := make([]interface{}, argc)
-		curarg := 0
-		b.doCursorSpecification(el, qargs, &curarg)
-		return b.Builder.String(), qargs
-	default:
-		return "", []interface{}{}
+		b.doCursorSpecification(el)
 	}
+	shape.record(b.Len(), len(b.args))
+	return b.Builder.String(), b.args
 }
 
 // InterpolationMarker returns a string with an interpolation marker of the
 // specified dialect and position
 func InterpolationMarker(opts types.Options, position int) string {
-	b := &strings.Builder{}
-	if opts.Dialect() == types.DialectPostgreSQL {
-		b.WriteString(symbol.Dollar)
-		b.WriteString(strconv.Itoa(position + 1))
-	} else {
-		b.WriteString(symbol.QuestionMark)
-	}
-	return b.String()
+	return dialect.Get(opts.Dialect()).Placeholder(position)
 }
 
 // New returns a builder for the supplied dialect
 func New(
 	mods ...types.Option,
 ) *Builder {
-	opts := types.MergeOptions(mods)
-	return &Builder{
-		opts: opts,
+	b := &Builder{}
+	b.reset(types.MergeOptions(mods))
+	return b
+}
+
+// reset prepares the Builder to build a new target with the supplied Options
+func (b *Builder) reset(opts types.Options) {
+	b.Builder.Reset()
+	if opts.Dialect() != b.opts.Dialect() || b.dialect == nil {
+		b.placeholders = b.placeholders[:0]
 	}
+	b.opts = opts
+	b.dialect = dialect.Get(opts.Dialect())
+	b.top = nil
+	b.err = nil
+	b.validate = false
+	b.scopes = b.scopes[:0]
+	b.lastScope = nil
+	b.scopeNames = b.scopeNames[:0]
+	b.depth = 0
+	b.fingerprint = false
+	b.compiling = false
+	// The query args are returned to the caller, so we never reuse them
+	b.args = nil
+}
+
+// supports returns true if the Builder's dialect supports the supplied
+// Feature at the Options' dialect version
+func (b *Builder) supports(feature dialect.Feature) bool {
+	return b.dialect.Supports(feature, b.opts.DialectVersion())
+}
+
+// unsupportedFeature records that the supplied clause or statement has no
+// equivalent in the Builder's dialect. Only the first such error is kept.
+func (b *Builder) unsupportedFeature(what string) {
+	b.invalid(fmt.Errorf(
+		"%w: %s is not supported by %s",
+		types.FeatureNotSupported, what, b.dialect.Name(),
+	))
 }
@@ -0,0 +1,114 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireRelease(t *testing.T) {
+	assert := assert.New(t)
+	users := testutil.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	sel := expr.Select(colUserName).
+		Where(expr.In(colUserId, 1, 2)).
+		LimitWithOffset(10, 20)
+
+	pgb := builder.Acquire(types.WithDialect(types.DialectPostgreSQL))
+	pgqs, pgqargs, err := pgb.Build(sel)
+	require.Nil(t, err)
+	pgb.Release()
+
+	// A released Builder is reset for the Options of its next user, and
+	// the SQL string and query args returned before it was released are
+	// unaffected by its reuse
+	sel.OrderBy(colUserName)
+	for x := 0; x < 3; x++ {
+		b := builder.Acquire(types.WithDialect(types.DialectTSQL))
+		qs, qargs, err := b.Build(sel)
+		require.Nil(t, err)
+		b.Release()
+		assert.Equal(
+			"SELECT users.name FROM users WHERE users.id IN(@p1, @p2) ORDER BY users.name OFFSET @p3 ROWS FETCH NEXT @p4 ROWS ONLY",
+			qs,
+		)
+		assert.Equal([]interface{}{1, 2, 20, 10}, qargs)
+	}
+	assert.Equal(
+		"SELECT users.name FROM users WHERE users.id IN($1, $2) LIMIT $3 OFFSET $4",
+		pgqs,
+	)
+	assert.Equal([]interface{}{1, 2, 10, 20}, pgqargs)
+}
+
+func BenchmarkSelect(b *testing.B) {
+	users := testutil.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	sel := expr.Select(colUserId, colUserName).
+		Where(expr.Equal(colUserName, "foo")).
+		Limit(10)
+	benchmarkBuild(b, sel, types.WithDialect(types.DialectPostgreSQL))
+}
+
+func BenchmarkJoin(b *testing.B) {
+	m := testutil.M()
+	users := m.T("users")
+	articles := m.T("articles")
+	articleStates := m.T("article_states")
+	colUserName := users.C("name")
+	colUserId := users.C("id")
+	colArticleId := articles.C("id")
+	colArticleAuthor := articles.C("author")
+	colArticleState := articles.C("state")
+	colArticleStateId := articleStates.C("id")
+	colArticleStateName := articleStates.C("name")
+
+	sel := expr.Select(colArticleId, colUserName, colArticleStateName).
+		Join(users, expr.Equal(colArticleAuthor, colUserId)).
+		Join(articleStates, expr.Equal(colArticleState, colArticleStateId)).
+		Where(expr.In(colArticleState, 1, 2, 3))
+	benchmarkBuild(b, sel, types.WithDialect(types.DialectMySQL))
+}
+
+func BenchmarkInsert(b *testing.B) {
+	users := testutil.T("users")
+
+	ins := expr.Insert(users, map[string]interface{}{
+		"id":         1,
+		"name":       "foo",
+		"created_on": "now",
+	})
+	benchmarkBuild(b, ins, types.WithDialect(types.DialectPostgreSQL))
+}
+
+// benchmarkBuild reports the time and allocations taken to build the
+// supplied target with a pooled Builder
+func benchmarkBuild(
+	b *testing.B,
+	target interface{},
+	opts ...types.Option,
+) {
+	b.ReportAllocs()
+	for x := 0; x < b.N; x++ {
+		bld := builder.Acquire(opts...)
+		if _, _, err := bld.Build(target); err != nil {
+			b.Fatal(err)
+		}
+		bld.Release()
+	}
+}
@@ -12,10 +12,9 @@ import (
 
 func (b *Builder) doColumnReference(
 	el *grammar.ColumnReference,
-	qargs []interface{},
-	curarg *int,
 ) {
+	b.checkColumnReference(el)
 	if el.BasicIdentifierChain != nil {
-		b.doIdentifierChain(el.BasicIdentifierChain, qargs, curarg)
+		b.doIdentifierChain(el.BasicIdentifierChain)
 	}
 }
@@ -7,19 +7,42 @@
 package builder
 
 import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
 )
 
 func (b *Builder) doCursorSpecification(
 	el *grammar.CursorSpecification,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doQueryExpression(&el.Query, qargs, curarg)
+	tsql := b.dialect.LimitStyle() == dialect.LimitStyleTopOffsetFetch
+	// TOP is valid without ORDER BY, but OFFSET and FETCH are not
+	if b.validate && tsql && el.Limit != nil && el.Limit.Offset != nil &&
+		el.OrderBy == nil {
+		b.invalid(fmt.Errorf(
+			"%w: %s", types.LimitWithoutOrderBy, b.dialect.Name(),
+		))
+	}
+	// T-SQL has no LIMIT clause. A row count without an offset is output as
+	// a TOP clause of the query specification, and a row count with an
+	// offset is output as an OFFSET and FETCH clause, which requires an
+	// ORDER BY clause.
+	if tsql && el.Limit != nil && el.Limit.Offset == nil {
+		b.top = el.Limit
+	}
+	b.doQueryExpression(&el.Query)
 	if el.OrderBy != nil {
-		b.doOrderByClause(el.OrderBy, qargs, curarg)
+		// The ORDER BY clause may refer to the relations of the query
+		// expression, whose scope has already been popped
+		b.pushScope(nil, b.lastScope...)
+		b.doOrderByClause(el.OrderBy)
+		b.popScope()
+	} else if tsql && el.Limit != nil && el.Limit.Offset != nil {
+		b.doUnorderedOrderByClause()
 	}
 	if el.Limit != nil {
-		b.doLimitClause(el.Limit, qargs, curarg)
+		b.doLimitClause(el.Limit)
 	}
 }
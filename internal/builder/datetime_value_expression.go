@@ -12,38 +12,30 @@ import (
 
 func (b *Builder) doDatetimeValueExpression(
 	el *grammar.DatetimeValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Unary != nil {
-		b.doDatetimeTerm(el.Unary, qargs, curarg)
+		b.doDatetimeTerm(el.Unary)
 	}
 }
 
 func (b *Builder) doDatetimeTerm(
 	el *grammar.DatetimeTerm,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doDatetimeFactor(&el.Factor, qargs, curarg)
+	b.doDatetimeFactor(&el.Factor)
 }
 
 func (b *Builder) doDatetimeFactor(
 	el *grammar.DatetimeFactor,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doDatetimePrimary(&el.Primary, qargs, curarg)
+	b.doDatetimePrimary(&el.Primary)
 }
 
 func (b *Builder) doDatetimePrimary(
 	el *grammar.DatetimePrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doValueExpressionPrimary(el.Primary)
 	} else if el.Function != nil {
-		b.doDatetimeValueFunction(el.Function, qargs, curarg)
+		b.doDatetimeValueFunction(el.Function)
 	}
 }
@@ -9,46 +9,47 @@ package builder
 import (
 	"strconv"
 
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
 func (b *Builder) doDatetimeValueFunction(
 	el *grammar.DatetimeValueFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.CurrentDate {
-		b.WriteString(symbol.CurrentDate)
-		b.WriteString(symbol.LeftParen)
-		b.WriteString(symbol.RightParen)
+		b.doDatetimeFunction(symbol.CurrentDate, nil)
 	} else if el.CurrentTime != nil {
-		b.WriteString(symbol.CurrentTime)
-		b.WriteString(symbol.LeftParen)
-		if el.CurrentTime.Precision != nil {
-			b.WriteString(strconv.Itoa(int(*el.CurrentTime.Precision)))
-		}
-		b.WriteString(symbol.RightParen)
+		b.doDatetimeFunction(symbol.CurrentTime, el.CurrentTime.Precision)
 	} else if el.CurrentTimestamp != nil {
-		b.WriteString(symbol.CurrentTimestamp)
-		b.WriteString(symbol.LeftParen)
-		if el.CurrentTimestamp.Precision != nil {
-			b.WriteString(strconv.Itoa(int(*el.CurrentTimestamp.Precision)))
-		}
-		b.WriteString(symbol.RightParen)
+		b.doDatetimeFunction(
+			symbol.CurrentTimestamp, el.CurrentTimestamp.Precision,
+		)
 	} else if el.LocalTime != nil {
-		b.WriteString(symbol.LocalTime)
-		b.WriteString(symbol.LeftParen)
-		if el.LocalTime.Precision != nil {
-			b.WriteString(strconv.Itoa(int(*el.LocalTime.Precision)))
-		}
-		b.WriteString(symbol.RightParen)
+		b.doDatetimeFunction(symbol.LocalTime, el.LocalTime.Precision)
 	} else if el.LocalTimestamp != nil {
-		b.WriteString(symbol.LocalTimestamp)
-		b.WriteString(symbol.LeftParen)
-		if el.LocalTimestamp.Precision != nil {
-			b.WriteString(strconv.Itoa(int(*el.LocalTimestamp.Precision)))
-		}
-		b.WriteString(symbol.RightParen)
+		b.doDatetimeFunction(symbol.LocalTimestamp, el.LocalTimestamp.Precision)
 	}
 }
+
+// doDatetimeFunction outputs the supplied ANSI SQL datetime value function
+// with the supplied optional fractional seconds precision. In ANSI SQL, the
+// function is only followed by parentheses when it has a precision, e.g.
+// CURRENT_TIMESTAMP or CURRENT_TIMESTAMP(3). Dialects that output the
+// function as an ordinary function call always output the parentheses, e.g.
+// GETDATE().
+func (b *Builder) doDatetimeFunction(name string, precision *uint) {
+	syntax := b.functionSyntax(name)
+	if precision != nil && !b.supports(dialect.FeatureDatetimePrecision) {
+		b.unsupported(name + " precision")
+	}
+	b.doKeyword(b.dialect.FunctionName(name))
+	if precision == nil && syntax == dialect.FunctionSyntaxStandard {
+		return
+	}
+	b.WriteString(symbol.LeftParen)
+	if precision != nil {
+		b.WriteString(strconv.Itoa(int(*precision)))
+	}
+	b.WriteString(symbol.RightParen)
+}
@@ -7,23 +7,149 @@
 package builder
 
 import (
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/internal/inspect"
 )
 
 func (b *Builder) doDeleteStatementSearched(
 	el *grammar.DeleteStatementSearched,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Delete)
+	b.pushScope(el.Using, el.TableName)
+	defer b.popScope()
+	if len(el.Using) > 0 {
+		b.doMultiTableDeleteStatement(el)
+		return
+	}
+	if el.Limit != nil && !b.supports(dialect.FeatureDMLOrderByLimit) {
+		switch {
+		case b.dialect.RowIdentifier() != "":
+			// Neither PostgreSQL nor SQLite (unless compiled with
+			// SQLITE_ENABLE_UPDATE_DELETE_LIMIT) support ORDER BY or LIMIT
+			// in a DELETE statement, so we select the row identifiers of
+			// the rows to delete in a subquery:
+			//
+			// DELETE FROM t WHERE ctid IN (SELECT ctid FROM t WHERE ... ORDER BY ... LIMIT $1)
+			b.doKeyword(symbol.Delete)
+			b.WriteString(symbol.Space)
+			b.doKeyword(symbol.From)
+			b.WriteString(symbol.Space)
+			b.doTableName(el.SchemaName, el.TableName)
+			b.doLimitedRowsSubquery(
+				el.SchemaName, el.TableName, el.Where, el.OrderBy, el.Limit,
+			)
+			b.doReturningClause(el.Returning, "")
+			return
+		case b.dialect.LimitStyle() == dialect.LimitStyleTopOffsetFetch:
+			// T-SQL only supports TOP in a DELETE statement without an ORDER
+			// BY, so we select the rows to delete in a common table
+			// expression and delete from the CTE:
+			//
+			// WITH cte AS (SELECT TOP (?) * FROM t WHERE ... ORDER BY ...) DELETE FROM cte
+			b.doLimitedRowsCommonTableExpression(
+				el.SchemaName, el.TableName, el.Where, el.OrderBy, el.Limit,
+			)
+			b.doKeyword(symbol.Delete)
+			b.WriteString(symbol.Space)
+			b.doKeyword(symbol.From)
+			b.WriteString(symbol.Space)
+			b.WriteString(limitedRowsCommonTableExpressionName)
+			b.doOutputClause(el.Returning, symbol.Deleted)
+			return
+		}
+	}
+	b.doKeyword(symbol.Delete)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.From)
+	b.doKeyword(symbol.From)
 	b.WriteString(symbol.Space)
 	// We don't add any table alias when outputting the table identifier
-	b.WriteString(el.TableName)
+	b.doTableName(el.SchemaName, el.TableName)
+	b.doOutputClause(el.Returning, symbol.Deleted)
 
 	if el.Where != nil {
-		b.doWhereClause(el.Where, qargs, curarg)
+		b.doWhereClause(el.Where)
+	}
+	if b.supports(dialect.FeatureDMLOrderByLimit) {
+		b.doOrderByLimitClauses(el.OrderBy, el.Limit)
+	}
+	b.doReturningClause(el.Returning, "")
+}
+
+// doMultiTableDeleteStatement outputs a DELETE statement that joins the
+// target table to one or more other tables. Each dialect has its own syntax
+// for this:
+//
+// MySQL and T-SQL:
+//
+// DELETE t FROM t JOIN o ON t.a = o.b WHERE ...
+//
+// PostgreSQL:
+//
+// DELETE FROM t USING o WHERE t.a = o.b AND ...
+//
+// SQLite has no multi-table DELETE syntax, so we use a correlated subquery:
+//
+// DELETE FROM t WHERE EXISTS (SELECT 1 FROM o WHERE t.a = o.b AND ...)
+func (b *Builder) doMultiTableDeleteStatement(
+	el *grammar.DeleteStatementSearched,
+) {
+	prims, ons, _ := inspect.InnerJoinedTablePrimaries(el.Using)
+	target := inspect.TablePrimaryByTableName(prims, el.TableName)
+	targetName := el.TableName
+	if target != nil && target.Correlation != nil {
+		targetName = target.Correlation.Name
+	}
+	b.doKeyword(symbol.Delete)
+	b.WriteString(symbol.Space)
+	switch b.dialect.DeleteJoinStyle() {
+	case dialect.DeleteJoinStyleUsing:
+		b.doKeyword(symbol.From)
+		b.WriteString(symbol.Space)
+		if target != nil {
+			b.doTablePrimary(target)
+		} else {
+			b.doTableName(el.SchemaName, el.TableName)
+		}
+		b.doClauseSeparator()
+		b.doKeyword(symbol.Using)
+		b.WriteString(symbol.Space)
+		b.doTablePrimariesExcept(prims, target)
+		b.doSearchConditions(ons, el.Where)
+		b.doReturningClause(el.Returning, targetName)
+	case dialect.DeleteJoinStyleExists:
+		b.doKeyword(symbol.From)
+		b.WriteString(symbol.Space)
+		if target != nil {
+			b.doTablePrimary(target)
+		} else {
+			b.doTableName(el.SchemaName, el.TableName)
+		}
+		b.doClauseSeparator()
+		b.doKeyword(symbol.Where)
+		b.WriteString(symbol.Space)
+		b.doKeyword(symbol.Exists)
+		b.WriteString(symbol.Space)
+		b.doSubqueryOpen()
+		b.doKeyword(symbol.Select)
+		b.WriteString(" 1 ")
+		b.doKeyword(symbol.From)
+		b.WriteString(symbol.Space)
+		b.doTablePrimariesExcept(prims, target)
+		b.doSearchConditions(ons, el.Where)
+		b.doSubqueryClose()
+		b.doReturningClause(el.Returning, targetName)
+	default:
+		if target != nil && target.Correlation != nil {
+			b.doIdentifier(target.Correlation.Name)
+		} else {
+			b.doTableName(el.SchemaName, el.TableName)
+		}
+		b.doOutputClause(el.Returning, symbol.Deleted)
+		b.doFromClause(&grammar.FromClause{TableReferences: el.Using})
+		if el.Where != nil {
+			b.doWhereClause(el.Where)
+		}
+		b.doReturningClause(el.Returning, targetName)
 	}
 }
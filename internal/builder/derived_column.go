@@ -13,14 +13,12 @@ import (
 
 func (b *Builder) doDerivedColumn(
 	el *grammar.DerivedColumn,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doValueExpression(&el.Value, qargs, curarg)
+	b.doValueExpression(&el.Value)
 	if el.As != nil {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.As)
+		b.doKeyword(symbol.As)
 		b.WriteString(symbol.Space)
-		b.WriteString(*el.As)
+		b.doIdentifier(*el.As)
 	}
 }
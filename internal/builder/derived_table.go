@@ -12,8 +12,6 @@ import (
 
 func (b *Builder) doDerivedTable(
 	el *grammar.DerivedTable,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doSubquery(&el.Subquery, qargs, curarg)
+	b.doSubquery(&el.Subquery)
 }
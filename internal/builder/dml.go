@@ -0,0 +1,215 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"github.com/jaypipes/sqlb/core/dialect"
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+)
+
+// limitedRowsCommonTableExpressionName is the name of the common table
+// expression used to limit the rows affected by a T-SQL UPDATE or DELETE
+// statement.
+const limitedRowsCommonTableExpressionName = "sqlb_limited"
+
+// doTablePrimariesExcept outputs a comma-separated list of the supplied
+// TablePrimary elements, skipping the supplied excluded TablePrimary.
+func (b *Builder) doTablePrimariesExcept(
+	prims []*grammar.TablePrimary,
+	exclude *grammar.TablePrimary,
+) {
+	x := 0
+	for _, p := range prims {
+		if p == exclude {
+			continue
+		}
+		if x > 0 {
+			b.WriteString(symbol.Comma)
+			b.WriteString(symbol.Space)
+		}
+		b.doTablePrimary(p)
+		x++
+	}
+}
+
+// doSearchConditions outputs a WHERE clause that ANDs together the supplied
+// join conditions and the optional WHERE clause. Nothing is output if there
+// are no join conditions and the WHERE clause is nil.
+func (b *Builder) doSearchConditions(
+	ons []*grammar.BooleanValueExpression,
+	where *grammar.WhereClause,
+) {
+	if len(ons) == 0 && where == nil {
+		return
+	}
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Where)
+	b.WriteString(symbol.Space)
+	for x, on := range ons {
+		if x > 0 {
+			b.WriteString(symbol.Space)
+			b.doKeyword(symbol.And)
+			b.WriteString(symbol.Space)
+		}
+		b.doBooleanValueExpression(on)
+	}
+	if where != nil {
+		if len(ons) > 0 {
+			b.WriteString(symbol.Space)
+			b.doKeyword(symbol.And)
+			b.WriteString(symbol.Space)
+		}
+		b.doBooleanValueExpression(&where.Search)
+	}
+}
+
+// doOrderByLimitClauses outputs the supplied ORDER BY and LIMIT clauses of a
+// single-table UPDATE or DELETE statement. This syntax is only supported by
+// MySQL.
+func (b *Builder) doOrderByLimitClauses(
+	orderBy *grammar.OrderByClause,
+	limit *grammar.LimitClause,
+) {
+	if orderBy != nil {
+		b.doOrderByClause(orderBy)
+	}
+	if limit != nil {
+		b.doLimitClause(limit)
+	}
+}
+
+// doLimitedRowsSubquery outputs a WHERE clause that restricts the rows of an
+// UPDATE or DELETE statement to the row identifiers returned by an ordered and
+// limited subquery against the target table. PostgreSQL uses the ctid system
+// column and SQLite uses the rowid column as the row identifier.
+func (b *Builder) doLimitedRowsSubquery(
+	schemaName *string,
+	tableName string,
+	where *grammar.WhereClause,
+	orderBy *grammar.OrderByClause,
+	limit *grammar.LimitClause,
+) {
+	rowID := b.dialect.RowIdentifier()
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Where)
+	b.WriteString(symbol.Space)
+	b.WriteString(rowID)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.In)
+	b.WriteString(symbol.Space)
+	b.doSubqueryOpen()
+	b.doKeyword(symbol.Select)
+	b.WriteString(symbol.Space)
+	b.WriteString(rowID)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.From)
+	b.WriteString(symbol.Space)
+	b.doTableName(schemaName, tableName)
+	if where != nil {
+		b.doWhereClause(where)
+	}
+	b.doOrderByLimitClauses(orderBy, limit)
+	b.doSubqueryClose()
+}
+
+// doLimitedRowsCommonTableExpression outputs a T-SQL common table expression
+// that selects the TOP N rows of the target table of an UPDATE or DELETE
+// statement, ordered by the optional ORDER BY clause. The UPDATE or DELETE
+// statement then targets the common table expression.
+func (b *Builder) doLimitedRowsCommonTableExpression(
+	schemaName *string,
+	tableName string,
+	where *grammar.WhereClause,
+	orderBy *grammar.OrderByClause,
+	limit *grammar.LimitClause,
+) {
+	b.doKeyword(symbol.With)
+	b.WriteString(symbol.Space)
+	b.WriteString(limitedRowsCommonTableExpressionName)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.As)
+	b.WriteString(symbol.Space)
+	b.doSubqueryOpen()
+	b.doKeyword(symbol.Select)
+	b.WriteString(symbol.Space)
+	b.doTopClause(limit)
+	b.WriteString(symbol.Space)
+	b.WriteString(symbol.Asterisk)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.From)
+	b.WriteString(symbol.Space)
+	b.doTableName(schemaName, tableName)
+	if where != nil {
+		b.doWhereClause(where)
+	}
+	if orderBy != nil {
+		b.doOrderByClause(orderBy)
+	}
+	b.doSubqueryClose()
+	b.doClauseSeparator()
+}
+
+// doReturningClause outputs the supplied RETURNING clause of an INSERT,
+// UPDATE or DELETE statement. Nothing is output for T-SQL, which uses an
+// OUTPUT clause instead. An error is recorded for dialects that have neither.
+// If the supplied qualifier is not empty, each column name is prefixed with
+// the qualifier, so that the columns of the target table of a multi-table
+// statement are not ambiguous.
+func (b *Builder) doReturningClause(
+	el *grammar.ReturningClause,
+	qualifier string,
+) {
+	if el == nil {
+		return
+	}
+	if !b.supports(dialect.FeatureReturning) {
+		if !b.supports(dialect.FeatureOutputClause) {
+			b.unsupportedFeature(symbol.Returning)
+		}
+		return
+	}
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Returning)
+	b.WriteString(symbol.Space)
+	for x, c := range el.Columns {
+		if x > 0 {
+			b.WriteString(symbol.Comma)
+			b.WriteString(symbol.Space)
+		}
+		if qualifier != "" {
+			b.doIdentifier(qualifier)
+			b.WriteString(symbol.Period)
+		}
+		b.doIdentifier(c)
+	}
+}
+
+// doOutputClause outputs the supplied RETURNING clause of an INSERT, UPDATE or
+// DELETE statement as a T-SQL OUTPUT clause. Each column is qualified with the
+// supplied pseudo-table name (INSERTED or DELETED). Nothing is output for
+// dialects other than T-SQL.
+func (b *Builder) doOutputClause(
+	el *grammar.ReturningClause,
+	pseudoTable string,
+) {
+	if el == nil || !b.supports(dialect.FeatureOutputClause) {
+		return
+	}
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Output)
+	b.WriteString(symbol.Space)
+	for x, c := range el.Columns {
+		if x > 0 {
+			b.WriteString(symbol.Comma)
+			b.WriteString(symbol.Space)
+		}
+		b.doKeyword(pseudoTable)
+		b.WriteString(symbol.Period)
+		b.doIdentifier(c)
+	}
+}
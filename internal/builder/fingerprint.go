@@ -0,0 +1,92 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// Fingerprint returns a stable hash and a normalized SQL string describing
+// the shape of the supplied target, for tagging metrics by query. The target
+// may be anything accepted by Builder.Build.
+//
+// The normalized SQL is independent of the values of query args: every
+// placeholder is output as ? regardless of the Dialect, and an IN predicate
+// with any number of values is output as IN(?). It is also independent of the
+// order in which the comma-separated relations of a FROM clause were
+// supplied, which are ordered by name, so that the same logical query always
+// has the same fingerprint.
+//
+// Only the Dialect, DialectVersion and QuoteMode of the supplied Options
+// affect the normalized SQL. Formatting Options are ignored.
+func Fingerprint(
+	target interface{},
+	opts ...types.Option,
+) (string, string, error) {
+	el := element(target)
+	if el == nil {
+		return "", "", fmt.Errorf(
+			"%w: %T", types.UnsupportedBuildTarget, target,
+		)
+	}
+	o := types.MergeOptions(opts)
+	b := Acquire(
+		types.WithDialect(o.Dialect()),
+		types.WithDialectVersion(o.DialectVersion()),
+		types.WithQuoteMode(o.QuoteMode()),
+	)
+	defer b.Release()
+	b.fingerprint = true
+	qs, _, err := b.StringArgsE(el)
+	if err != nil {
+		return "", "", err
+	}
+	h := fnv.New64a()
+	h.Write([]byte(qs))
+	return fmt.Sprintf("%016x", h.Sum64()), qs, nil
+}
+
+// sortedTableReferences returns a copy of the supplied table references,
+// ordered by the names of the relations they contain
+func sortedTableReferences(
+	refs []grammar.TableReference,
+) []grammar.TableReference {
+	keys := make([]string, len(refs))
+	sorted := make([]grammar.TableReference, len(refs))
+	for x := range refs {
+		keys[x] = strings.Join(tableReferenceNames(nil, &refs[x]), ",")
+	}
+	idx := make([]int, len(refs))
+	for x := range idx {
+		idx[x] = x
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return keys[idx[i]] < keys[idx[j]]
+	})
+	for x, i := range idx {
+		sorted[x] = refs[i]
+	}
+	return sorted
+}
+
+// collapsesInList returns true if the supplied IN predicate's values are all
+// query args, in which case the values are output as a single placeholder
+// when fingerprinting
+func collapsesInList(el *grammar.InPredicate) bool {
+	for _, v := range el.Values {
+		if v.Primary == nil || v.Primary.UnsignedValue == nil {
+			return false
+		}
+	}
+	return true
+}
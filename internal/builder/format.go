@@ -0,0 +1,68 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// doKeyword outputs the supplied SQL keyword, or sequence of keywords, e.g.
+// "ORDER BY" or "CHAR_LENGTH", in the case required by the Options'
+// KeywordCase. Everything else, including identifiers, is written unchanged
+// with WriteString.
+func (b *Builder) doKeyword(s string) {
+	switch b.opts.FormatKeywordCase() {
+	case types.KeywordCaseUpper:
+		s = strings.ToUpper(s)
+	case types.KeywordCaseLower:
+		s = strings.ToLower(s)
+	}
+	b.WriteString(s)
+}
+
+// doClauseSeparator outputs the separator between two clauses, which is a
+// line break when pretty printing
+func (b *Builder) doClauseSeparator() {
+	if b.opts.FormatPretty() {
+		b.doLineBreak(b.depth)
+		return
+	}
+	b.WriteString(b.opts.FormatSeparateClauseWith())
+}
+
+// doLineBreak outputs a line break followed by the indentation for the
+// supplied level of nesting
+func (b *Builder) doLineBreak(depth int) {
+	b.WriteString("\n")
+	for x := 0; x < depth; x++ {
+		b.WriteString(b.opts.FormatIndent())
+	}
+}
+
+// doSubqueryOpen outputs the opening parenthesis of a subquery. When pretty
+// printing, the subquery starts on the following line, indented one level
+// deeper than the enclosing query.
+func (b *Builder) doSubqueryOpen() {
+	b.WriteString(symbol.LeftParen)
+	if b.opts.FormatPretty() {
+		b.depth++
+		b.doLineBreak(b.depth)
+	}
+}
+
+// doSubqueryClose outputs the closing parenthesis of a subquery, on its own
+// line when pretty printing
+func (b *Builder) doSubqueryClose() {
+	if b.opts.FormatPretty() {
+		b.depth--
+		b.doLineBreak(b.depth)
+	}
+	b.WriteString(symbol.RightParen)
+}
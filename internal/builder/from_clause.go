@@ -13,17 +13,21 @@ import (
 
 func (b *Builder) doFromClause(
 	el *grammar.FromClause,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.From)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.From)
 	b.WriteString(symbol.Space)
-	for x, tr := range el.TableReferences {
+	refs := el.TableReferences
+	if b.fingerprint {
+		// The order of comma-separated relations does not change the
+		// meaning of the query
+		refs = sortedTableReferences(refs)
+	}
+	for x, tr := range refs {
 		if x > 0 {
 			b.WriteString(symbol.Comma)
 			b.WriteString(symbol.Space)
 		}
-		b.doTableReference(&tr, qargs, curarg)
+		b.doTableReference(&tr)
 	}
 }
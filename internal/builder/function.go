@@ -0,0 +1,60 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/dialect"
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// functionSyntax returns the syntax that the Builder's dialect uses for the
+// supplied ANSI SQL function name, recording an error if the dialect has no
+// equivalent of the function. The ANSI SQL syntax is returned in that case so
+// that building can continue.
+func (b *Builder) functionSyntax(name string) dialect.FunctionSyntax {
+	syntax := b.dialect.FunctionSyntax(name)
+	if syntax == dialect.FunctionSyntaxUnsupported {
+		b.unsupported(name)
+		return dialect.FunctionSyntaxStandard
+	}
+	return syntax
+}
+
+// unsupported records that the supplied function, or form of a function, has
+// no equivalent in the Builder's dialect. Only the first such error is kept.
+func (b *Builder) unsupported(what string) {
+	b.invalid(fmt.Errorf(
+		"%w: %s is not supported by %s",
+		types.FunctionNotSupported, what, b.dialect.Name(),
+	))
+}
+
+// doFunctionName outputs the Builder's dialect's name for the supplied ANSI
+// SQL function name
+func (b *Builder) doFunctionName(name string) {
+	b.functionSyntax(name)
+	b.doKeyword(b.dialect.FunctionName(name))
+}
+
+// doFunctionCall outputs the supplied ANSI SQL function as an ordinary
+// function call in the Builder's dialect. Each of the supplied arguments
+// outputs one operand of the function.
+func (b *Builder) doFunctionCall(name string, args ...func()) {
+	b.doKeyword(b.dialect.FunctionName(name))
+	b.WriteString(symbol.LeftParen)
+	for x, arg := range args {
+		if x > 0 {
+			b.WriteString(symbol.Comma)
+			b.WriteString(symbol.Space)
+		}
+		arg()
+	}
+	b.WriteString(symbol.RightParen)
+}
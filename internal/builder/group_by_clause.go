@@ -13,41 +13,35 @@ import (
 
 func (b *Builder) doGroupByClause(
 	el *grammar.GroupByClause,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Group)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Group)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.By)
+	b.doKeyword(symbol.By)
 	b.WriteString(symbol.Space)
 	for x, ge := range el.GroupingElements {
 		if x > 0 {
 			b.WriteString(symbol.Comma)
 			b.WriteString(symbol.Space)
 		}
-		b.doGroupingElement(&ge, qargs, curarg)
+		b.doGroupingElement(&ge)
 	}
 }
 
 func (b *Builder) doGroupingElement(
 	el *grammar.GroupingElement,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.OrdinaryGroupingSet != nil {
-		b.doOrdinaryGroupingSet(el.OrdinaryGroupingSet, qargs, curarg)
+		b.doOrdinaryGroupingSet(el.OrdinaryGroupingSet)
 	}
 }
 
 func (b *Builder) doOrdinaryGroupingSet(
 	el *grammar.OrdinaryGroupingSet,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doColumnReference(el.GroupingColumnReference.ColumnReference, qargs, curarg)
+	b.doColumnReference(el.GroupingColumnReference.ColumnReference)
 	if el.GroupingColumnReference.Collation != nil {
-		b.WriteString(symbol.Collate)
+		b.doKeyword(symbol.Collate)
 		b.WriteString(symbol.Space)
 		b.WriteString(*el.GroupingColumnReference.Collation)
 	}
@@ -13,11 +13,9 @@ import (
 
 func (b *Builder) doHavingClause(
 	el *grammar.HavingClause,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Having)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Having)
 	b.WriteString(symbol.Space)
-	b.doBooleanValueExpression(&el.Search, qargs, curarg)
+	b.doBooleanValueExpression(&el.Search)
 }
@@ -8,26 +8,90 @@ package builder
 
 import (
 	"strings"
+	"unicode"
 
 	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
 )
 
+// doIdentifier outputs the supplied identifier, delimiting it with the
+// dialect's quote characters if the Options' QuoteMode requires it. Any
+// closing quote character in the identifier is escaped by doubling it.
+func (b *Builder) doIdentifier(name string) {
+	if !b.needsQuote(name) {
+		b.WriteString(name)
+		return
+	}
+	open, close := b.dialect.IdentifierQuotes()
+	b.WriteString(open)
+	b.WriteString(strings.ReplaceAll(name, close, close+close))
+	b.WriteString(close)
+}
+
+// needsQuote returns true if the supplied identifier should be delimited
+func (b *Builder) needsQuote(name string) bool {
+	if name == "" || name == symbol.Asterisk {
+		return false
+	}
+	switch b.opts.QuoteMode() {
+	case types.QuoteNever:
+		return false
+	case types.QuoteAlways:
+		return true
+	}
+	if b.dialect.IsReserved(name) {
+		return true
+	}
+	for x, r := range name {
+		switch {
+		case r == '_':
+		case unicode.IsLetter(r):
+			// An identifier folded to lower case would no longer match
+			if b.dialect.FoldsToLowerCase() && unicode.IsUpper(r) {
+				return true
+			}
+		case unicode.IsDigit(r):
+			if x == 0 {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
 func (b *Builder) doIdentifierChain(
 	el *grammar.IdentifierChain,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(strings.Join(el.Identifiers, "."))
+	for x, id := range el.Identifiers {
+		if x > 0 {
+			b.WriteString(symbol.Period)
+		}
+		b.doIdentifier(id)
+	}
 }
 
 func (b *Builder) doSchemaQualifiedName(
 	el *grammar.SchemaQualifiedName,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.SchemaName != nil {
-		b.WriteString(*el.SchemaName)
-		b.WriteRune('.')
+		b.doIdentifier(*el.SchemaName)
+		b.WriteString(symbol.Period)
 	}
-	b.doIdentifierChain(&el.Identifiers, qargs, curarg)
+	b.doIdentifierChain(&el.Identifiers)
+}
+
+// doTableName outputs the supplied table name, qualified with the supplied
+// schema name if it is not nil.
+func (b *Builder) doTableName(schemaName *string, tableName string) {
+	b.doSchemaQualifiedName(
+		&grammar.SchemaQualifiedName{
+			SchemaName: schemaName,
+			Identifiers: grammar.IdentifierChain{
+				Identifiers: []string{tableName},
+			},
+		},
+	)
 }
@@ -13,15 +13,13 @@ import (
 
 func (b *Builder) doInsertStatement(
 	el *grammar.InsertStatement,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Insert)
+	b.doKeyword(symbol.Insert)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Into)
+	b.doKeyword(symbol.Into)
 	b.WriteString(symbol.Space)
 	// We don't add any table alias when outputting the table identifier
-	b.WriteString(el.TableName)
+	b.doTableName(el.SchemaName, el.TableName)
 	b.WriteString(symbol.Space)
 	b.WriteString(symbol.LeftParen)
 
@@ -32,11 +30,12 @@ func (b *Builder) doInsertStatement(
 		}
 		// We don't add the table identifier or use an alias when outputting
 		// the column names in the <columns> element of the INSERT statement
-		b.WriteString(c)
+		b.doIdentifier(c)
 	}
 	b.WriteString(symbol.RightParen)
+	b.doOutputClause(el.Returning, symbol.Inserted)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Values)
+	b.doKeyword(symbol.Values)
 	b.WriteString(symbol.Space)
 	b.WriteString(symbol.LeftParen)
 	for x, v := range el.Values {
@@ -44,9 +43,8 @@ func (b *Builder) doInsertStatement(
 			b.WriteString(symbol.Comma)
 			b.WriteString(symbol.Space)
 		}
-		b.WriteString(InterpolationMarker(b.opts, *curarg))
-		qargs[*curarg] = v
-		*curarg++
+		b.doScalar(v)
 	}
 	b.WriteString(symbol.RightParen)
+	b.doReturningClause(el.Returning, "")
 }
@@ -0,0 +1,128 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// Interpolate returns the SQL string for the supplied target with each query
+// arg output as a SQL literal of the Dialect in place of its placeholder. The
+// target may be anything accepted by Builder.Build. Unlike Build, the target
+// is not validated, so that invalid SQL can still be logged.
+//
+// Query args are output as follows:
+//
+// * nil and nil pointers are output as NULL
+// * strings are output as quoted and escaped character string literals
+// * []byte is output as a binary literal, e.g. X'DEADBEEF'
+// * time.Time is output as a timestamp literal
+// * booleans are output as the Dialect's boolean literals
+// * integers and floating point numbers are output as numeric literals
+// * values implementing `driver.Valuer` are output as the value returned by
+// their Value method
+// * named parameters created with `expr.Param` are output as their host
+// parameter name, e.g. :id
+//
+// Interpolate is intended ONLY for debugging and logging, e.g. to show the
+// exact SQL of a failing query. NEVER execute the SQL it returns. Escaping
+// values into SQL literals is not a substitute for passing query args to the
+// database driver, and the literals may not round-trip exactly, e.g. the
+// time zone of a time.Time is not output.
+func Interpolate(
+	target interface{},
+	opts ...types.Option,
+) (string, error) {
+	el := element(target)
+	if el == nil {
+		return "", fmt.Errorf(
+			"%w: %T", types.UnsupportedBuildTarget, target,
+		)
+	}
+	b := Acquire(append(opts, types.WithInlineArgs())...)
+	defer b.Release()
+	qs, _, err := b.StringArgsE(el)
+	return qs, err
+}
+
+// doInlineArg outputs the supplied query arg as a SQL literal
+func (b *Builder) doInlineArg(arg interface{}) {
+	lit, err := b.literal(arg)
+	if err != nil {
+		b.invalid(err)
+		return
+	}
+	switch lit {
+	case symbol.Null, symbol.True, symbol.False:
+		b.doKeyword(lit)
+	default:
+		b.WriteString(lit)
+	}
+}
+
+// literal returns the SQL literal of the Builder's dialect representing the
+// supplied query arg
+func (b *Builder) literal(arg interface{}) (string, error) {
+	if arg == nil {
+		return symbol.Null, nil
+	}
+	rv := reflect.ValueOf(arg)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return symbol.Null, nil
+	}
+	switch arg := arg.(type) {
+	case *grammar.HostParameterSpecification:
+		// A named parameter has no value until it is supplied to a
+		// Template, so we output its SQL host parameter name instead
+		return symbol.Colon + arg.Name, nil
+	case driver.Valuer:
+		v, err := arg.Value()
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", types.UnsupportedInlineArg, err)
+		}
+		return b.literal(v)
+	case time.Time:
+		return b.dialect.TimestampLiteral(arg), nil
+	case []byte:
+		return b.dialect.BinaryLiteral(arg), nil
+	}
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return b.literal(rv.Elem().Interface())
+	case reflect.String:
+		return b.dialect.StringLiteral(rv.String()), nil
+	case reflect.Bool:
+		return b.dialect.BooleanLiteral(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		// There is no SQL literal for NaN or infinity
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", fmt.Errorf("%w: %v", types.UnsupportedInlineArg, f)
+		}
+		return strconv.FormatFloat(f, 'g', -1, rv.Type().Bits()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return b.dialect.BinaryLiteral(rv.Bytes()), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %T", types.UnsupportedInlineArg, arg)
+}
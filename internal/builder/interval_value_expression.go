@@ -8,23 +8,20 @@ package builder
 
 import (
 	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
 func (b *Builder) doIntervalValueExpression(
 	el *grammar.IntervalValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 }
 
 func (b *Builder) doPrimaryDatetimeField(
 	el *grammar.PrimaryDatetimeField,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Second {
-		b.WriteString("SECOND")
+		b.doKeyword(symbol.Second)
 	} else {
-		b.WriteString(grammar.NonsecondPrimaryDatetimeFieldSymbols[el.Nonsecond])
+		b.doKeyword(grammar.NonsecondPrimaryDatetimeFieldSymbols[el.Nonsecond])
 	}
 }
@@ -7,106 +7,146 @@
 package builder
 
 import (
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
 func (b *Builder) doJoinedTable(
 	el *grammar.JoinedTable,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Qualified != nil {
-		b.doQualifiedJoin(el.Qualified, qargs, curarg)
+		b.doQualifiedJoin(el.Qualified)
 	} else if el.Natural != nil {
-		b.doNaturalJoin(el.Natural, qargs, curarg)
+		b.doNaturalJoin(el.Natural)
 	} else if el.Union != nil {
-		b.doUnionJoin(el.Union, qargs, curarg)
+		b.doUnionJoin(el.Union)
 	} else if el.Cross != nil {
-		b.doCrossJoin(el.Cross, qargs, curarg)
+		b.doCrossJoin(el.Cross)
 	}
 }
 
 func (b *Builder) doQualifiedJoin(
 	el *grammar.QualifiedJoin,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doTableReference(&el.Left, qargs, curarg)
-	b.WriteString(b.opts.FormatSeparateClauseWith())
+	if el.Type == grammar.JoinTypeRightOuter &&
+		!b.supports(dialect.FeatureRightJoin) {
+		b.doSwappedRightJoin(el)
+		return
+	}
+	b.doTableReference(&el.Left)
+	b.doClauseSeparator()
 	switch el.Type {
 	case grammar.JoinTypeInner:
-		b.WriteString(symbol.Join)
+		b.doKeyword(symbol.Join)
 		b.WriteString(symbol.Space)
 	case grammar.JoinTypeLeftOuter:
-		b.WriteString(symbol.Left)
+		b.doKeyword(symbol.Left)
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Join)
+		b.doKeyword(symbol.Join)
 		b.WriteString(symbol.Space)
-	case grammar.JoinTypeFullOuter:
-		b.WriteString(symbol.Cross)
+	case grammar.JoinTypeRightOuter:
+		b.doKeyword(symbol.Right)
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Join)
+		b.doKeyword(symbol.Join)
 		b.WriteString(symbol.Space)
+	case grammar.JoinTypeFullOuter:
+		b.doFullOuterJoinKeywords()
 	}
-	b.doTableReference(&el.Right, qargs, curarg)
+	b.doTableReference(&el.Right)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.On)
+	b.doKeyword(symbol.On)
+	b.WriteString(symbol.Space)
+	b.doBooleanValueExpression(&el.On)
+}
+
+// doSwappedRightJoin outputs the supplied RIGHT JOIN as the equivalent LEFT
+// JOIN with its operands swapped, for SQLite versions before 3.39 which do not
+// support RIGHT JOIN:
+//
+// SELECT ... FROM o LEFT JOIN t ON t.a = o.b
+//
+// If the left operand is itself a joined table, it is parenthesized:
+//
+// SELECT ... FROM o LEFT JOIN (t JOIN u ON t.c = u.d) ON t.a = o.b
+func (b *Builder) doSwappedRightJoin(
+	el *grammar.QualifiedJoin,
+) {
+	b.doTableReference(&el.Right)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Left)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Join)
+	b.WriteString(symbol.Space)
+	if el.Left.Joined != nil {
+		b.WriteString(symbol.LeftParen)
+		b.doTableReference(&el.Left)
+		b.WriteString(symbol.RightParen)
+	} else {
+		b.doTableReference(&el.Left)
+	}
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.On)
+	b.WriteString(symbol.Space)
+	b.doBooleanValueExpression(&el.On)
+}
+
+// doFullOuterJoinKeywords outputs FULL OUTER JOIN, recording an error for
+// dialects, such as MySQL, that have no FULL OUTER JOIN
+func (b *Builder) doFullOuterJoinKeywords() {
+	if !b.supports(dialect.FeatureFullJoin) {
+		b.unsupportedFeature("FULL OUTER JOIN")
+	}
+	b.doKeyword(symbol.Full)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Outer)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Join)
 	b.WriteString(symbol.Space)
-	b.doBooleanValueExpression(&el.On, qargs, curarg)
 }
 
 func (b *Builder) doNaturalJoin(
 	el *grammar.NaturalJoin,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doTableReference(&el.Left, qargs, curarg)
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Natural)
+	b.doTableReference(&el.Left)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Natural)
 	b.WriteString(symbol.Space)
 	switch el.Type {
 	case grammar.JoinTypeInner:
-		b.WriteString(symbol.Join)
+		b.doKeyword(symbol.Join)
 		b.WriteString(symbol.Space)
 	case grammar.JoinTypeLeftOuter:
-		b.WriteString(symbol.Left)
+		b.doKeyword(symbol.Left)
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Join)
+		b.doKeyword(symbol.Join)
 		b.WriteString(symbol.Space)
 	case grammar.JoinTypeFullOuter:
-		b.WriteString(symbol.Cross)
-		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Join)
-		b.WriteString(symbol.Space)
+		b.doFullOuterJoinKeywords()
 	}
-	b.doTablePrimary(&el.Right, qargs, curarg)
+	b.doTablePrimary(&el.Right)
 }
 
 func (b *Builder) doUnionJoin(
 	el *grammar.UnionJoin,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doTableReference(&el.Left, qargs, curarg)
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Union)
+	b.doTableReference(&el.Left)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Union)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Join)
+	b.doKeyword(symbol.Join)
 	b.WriteString(symbol.Space)
-	b.doTablePrimary(&el.Right, qargs, curarg)
+	b.doTablePrimary(&el.Right)
 }
 
 func (b *Builder) doCrossJoin(
 	el *grammar.CrossJoin,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doTableReference(&el.Left, qargs, curarg)
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Cross)
+	b.doTableReference(&el.Left)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Cross)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Join)
+	b.doKeyword(symbol.Join)
 	b.WriteString(symbol.Space)
-	b.doTablePrimary(&el.Right, qargs, curarg)
+	b.doTablePrimary(&el.Right)
 }
@@ -7,27 +7,70 @@
 package builder
 
 import (
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
 func (b *Builder) doLimitClause(
 	el *grammar.LimitClause,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Limit)
+	if b.dialect.LimitStyle() == dialect.LimitStyleTopOffsetFetch {
+		b.doOffsetFetchClause(el)
+		return
+	}
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Limit)
 	b.WriteString(symbol.Space)
-	b.WriteString(InterpolationMarker(b.opts, *curarg))
-	qargs[*curarg] = el.Count
-	*curarg++
+	b.doScalar(el.Count)
 	if el.Offset != nil {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Offset)
+		b.doKeyword(symbol.Offset)
 		b.WriteString(symbol.Space)
-		b.WriteString(InterpolationMarker(b.opts, *curarg))
-		qargs[*curarg] = *el.Offset
-		*curarg++
+		b.doScalar(*el.Offset)
+	}
+}
+
+// doTopClause outputs the supplied LIMIT clause as a T-SQL TOP clause,
+// followed by a trailing space:
+//
+// TOP (@p1)
+func (b *Builder) doTopClause(
+	el *grammar.LimitClause,
+) {
+	b.doKeyword(symbol.Top)
+	b.WriteString(symbol.Space)
+	b.WriteString(symbol.LeftParen)
+	b.doScalar(el.Count)
+	b.WriteString(symbol.RightParen)
+}
+
+// doOffsetFetchClause outputs the supplied LIMIT clause as a T-SQL OFFSET and
+// FETCH clause. Nothing is output when the LIMIT clause has no offset, since
+// the row count has already been output as a TOP clause of the query
+// specification.
+//
+// OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY
+func (b *Builder) doOffsetFetchClause(
+	el *grammar.LimitClause,
+) {
+	if el.Offset == nil {
+		return
 	}
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Offset)
+	b.WriteString(symbol.Space)
+	b.doScalar(*el.Offset)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Rows)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Fetch)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Next)
+	b.WriteString(symbol.Space)
+	b.doScalar(el.Count)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Rows)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Only)
 }
@@ -12,12 +12,10 @@ import (
 
 func (b *Builder) doUnsignedLiteral(
 	el *grammar.UnsignedLiteral,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.UnsignedNumeric != nil {
-		b.doScalar(el.UnsignedNumeric.Value, qargs, curarg)
+		b.doScalar(el.UnsignedNumeric.Value)
 	} else {
-		b.doScalar(el.General.Value, qargs, curarg)
+		b.doScalar(el.General.Value)
 	}
 }
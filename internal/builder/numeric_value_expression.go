@@ -12,43 +12,35 @@ import (
 
 func (b *Builder) doNumericValueExpression(
 	el *grammar.NumericValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Unary != nil {
-		b.doTerm(el.Unary, qargs, curarg)
+		b.doTerm(el.Unary)
 	}
 }
 
 func (b *Builder) doTerm(
 	el *grammar.Term,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Unary != nil {
-		b.doFactor(el.Unary, qargs, curarg)
+		b.doFactor(el.Unary)
 	}
 }
 
 func (b *Builder) doFactor(
 	el *grammar.Factor,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Sign != grammar.SignPlus {
 		b.WriteString(grammar.SignSymbol[el.Sign])
 	}
-	b.doNumericPrimary(&el.Primary, qargs, curarg)
+	b.doNumericPrimary(&el.Primary)
 }
 
 func (b *Builder) doNumericPrimary(
 	el *grammar.NumericPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doValueExpressionPrimary(el.Primary)
 	} else if el.Function != nil {
-		b.doNumericValueFunction(el.Function, qargs, curarg)
+		b.doNumericValueFunction(el.Function)
 	}
 }
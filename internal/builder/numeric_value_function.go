@@ -7,193 +7,249 @@
 package builder
 
 import (
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
 func (b *Builder) doNumericValueFunction(
 	el *grammar.NumericValueFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Position != nil {
-		b.doPositionExpression(el.Position, qargs, curarg)
+		b.doPositionExpression(el.Position)
 	} else if el.Length != nil {
-		b.doLengthExpression(el.Length, qargs, curarg)
+		b.doLengthExpression(el.Length)
 	} else if el.Extract != nil {
-		b.doExtractExpression(el.Extract, qargs, curarg)
+		b.doExtractExpression(el.Extract)
 	} else if el.Natural != nil {
-		b.doNaturalLogarithm(el.Natural, qargs, curarg)
+		b.doNaturalLogarithm(el.Natural)
 	} else if el.AbsoluteValue != nil {
-		b.doAbsoluteValueExpression(el.AbsoluteValue, qargs, curarg)
+		b.doAbsoluteValueExpression(el.AbsoluteValue)
 	} else if el.Exponential != nil {
-		b.doExponentialFunction(el.Exponential, qargs, curarg)
+		b.doExponentialFunction(el.Exponential)
 	} else if el.SquareRoot != nil {
-		b.doSquareRoot(el.SquareRoot, qargs, curarg)
+		b.doSquareRoot(el.SquareRoot)
 	} else if el.Ceiling != nil {
-		b.doCeilingFunction(el.Ceiling, qargs, curarg)
+		b.doCeilingFunction(el.Ceiling)
 	} else if el.Floor != nil {
-		b.doFloorFunction(el.Floor, qargs, curarg)
+		b.doFloorFunction(el.Floor)
 	}
 }
 
 func (b *Builder) doPositionExpression(
 	el *grammar.PositionExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.String != nil {
-		b.WriteString(symbol.Position)
+		subject := func() {
+			b.doStringValueExpression(&el.String.Subject)
+		}
+		in := func() {
+			b.doStringValueExpression(&el.String.In)
+		}
+		syntax := b.functionSyntax(symbol.Position)
+		if syntax != dialect.FunctionSyntaxStandard &&
+			el.String.Using != grammar.CharacterLengthUnitsCharacters {
+			b.unsupported(
+				symbol.Position + " " + symbol.Using + " " +
+					grammar.CharacterLengthUnitsSymbol[el.String.Using],
+			)
+		}
+		switch syntax {
+		case dialect.FunctionSyntaxArguments:
+			// CHARINDEX(a, b)
+			b.doFunctionCall(symbol.Position, subject, in)
+			return
+		case dialect.FunctionSyntaxReversedArguments:
+			// INSTR(b, a)
+			b.doFunctionCall(symbol.Position, in, subject)
+			return
+		}
+		b.doKeyword(symbol.Position)
 		b.WriteString(symbol.LeftParen)
-		b.doStringValueExpression(&el.String.Subject, qargs, curarg)
+		subject()
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.In)
+		b.doKeyword(symbol.In)
 		b.WriteString(symbol.Space)
-		b.doStringValueExpression(&el.String.In, qargs, curarg)
+		in()
 		if el.String.Using != grammar.CharacterLengthUnitsCharacters {
 			b.WriteString(symbol.Space)
-			b.WriteString(symbol.Using)
+			b.doKeyword(symbol.Using)
 			b.WriteString(symbol.Space)
-			b.WriteString(grammar.CharacterLengthUnitsSymbol[el.String.Using])
+			b.doKeyword(grammar.CharacterLengthUnitsSymbol[el.String.Using])
 		}
 		b.WriteString(symbol.RightParen)
 	} else if el.Blob != nil {
-		b.WriteString(symbol.Position)
+		subject := func() {
+			b.doBlobValueExpression(&el.Blob.Subject)
+		}
+		in := func() {
+			b.doBlobValueExpression(&el.Blob.In)
+		}
+		switch b.functionSyntax(symbol.Position) {
+		case dialect.FunctionSyntaxArguments:
+			b.doFunctionCall(symbol.Position, subject, in)
+			return
+		case dialect.FunctionSyntaxReversedArguments:
+			b.doFunctionCall(symbol.Position, in, subject)
+			return
+		}
+		b.doKeyword(symbol.Position)
 		b.WriteString(symbol.LeftParen)
-		b.doBlobValueExpression(&el.Blob.Subject, qargs, curarg)
+		subject()
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.In)
+		b.doKeyword(symbol.In)
 		b.WriteString(symbol.Space)
-		b.doBlobValueExpression(&el.Blob.In, qargs, curarg)
+		in()
 		b.WriteString(symbol.RightParen)
 	}
 }
 
 func (b *Builder) doLengthExpression(
 	el *grammar.LengthExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Character != nil {
-		b.WriteString(symbol.CharLength)
+		subject := func() {
+			b.doStringValueExpression(&el.Character.Subject)
+		}
+		if b.functionSyntax(symbol.CharLength) != dialect.FunctionSyntaxStandard {
+			// An ordinary function call has no USING clause, so a length
+			// in octets is output using the dialect's OCTET_LENGTH()
+			// equivalent
+			switch el.Character.Using {
+			case grammar.CharacterLengthUnitsCharacters:
+				b.doFunctionCall(symbol.CharLength, subject)
+			case grammar.CharacterLengthUnitsOctets:
+				b.functionSyntax(symbol.OctetLength)
+				b.doFunctionCall(symbol.OctetLength, subject)
+			default:
+				b.unsupported(
+					symbol.CharLength + " " + symbol.Using + " " +
+						grammar.CharacterLengthUnitsSymbol[el.Character.Using],
+				)
+				b.doFunctionCall(symbol.CharLength, subject)
+			}
+			return
+		}
+		b.doKeyword(symbol.CharLength)
 		b.WriteString(symbol.LeftParen)
-		b.doStringValueExpression(&el.Character.Subject, qargs, curarg)
+		subject()
 		if el.Character.Using != grammar.CharacterLengthUnitsCharacters {
 			b.WriteString(symbol.Space)
-			b.WriteString(symbol.Using)
+			b.doKeyword(symbol.Using)
 			b.WriteString(symbol.Space)
-			b.WriteString(grammar.CharacterLengthUnitsSymbol[el.Character.Using])
+			b.doKeyword(grammar.CharacterLengthUnitsSymbol[el.Character.Using])
 		}
 		b.WriteString(symbol.RightParen)
 	} else if el.Octet != nil {
-		b.WriteString(symbol.OctetLength)
+		b.doFunctionName(symbol.OctetLength)
 		b.WriteString(symbol.LeftParen)
-		b.doStringValueExpression(&el.Octet.Subject, qargs, curarg)
+		b.doStringValueExpression(&el.Octet.Subject)
 		b.WriteString(symbol.RightParen)
 	}
 }
 
 func (b *Builder) doExtractExpression(
 	el *grammar.ExtractExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Extract)
+	if b.functionSyntax(symbol.Extract) == dialect.FunctionSyntaxArguments {
+		// DATEPART(YEAR, x). Dialects without the EXTRACT syntax have no
+		// time zone fields or interval types.
+		if el.What.Timezone != nil {
+			b.unsupported(
+				symbol.Extract + " " +
+					grammar.TimezoneFieldSymbols[*el.What.Timezone],
+			)
+		}
+		if el.From.Interval != nil {
+			b.unsupported(symbol.Extract + " " + symbol.From + " " + symbol.Interval)
+		}
+		b.doFunctionCall(
+			symbol.Extract,
+			func() { b.doExtractField(&el.What) },
+			func() { b.doExtractSource(&el.From) },
+		)
+		return
+	}
+	b.doKeyword(symbol.Extract)
 	b.WriteString(symbol.LeftParen)
-	b.doExtractField(&el.What, qargs, curarg)
+	b.doExtractField(&el.What)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.From)
+	b.doKeyword(symbol.From)
 	b.WriteString(symbol.Space)
-	b.doExtractSource(&el.From, qargs, curarg)
+	b.doExtractSource(&el.From)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doExtractField(
 	el *grammar.ExtractField,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Datetime != nil {
-		b.doPrimaryDatetimeField(el.Datetime, qargs, curarg)
+		b.doPrimaryDatetimeField(el.Datetime)
 	} else if el.Timezone != nil {
-		b.WriteString(grammar.TimezoneFieldSymbols[*el.Timezone])
+		b.doKeyword(grammar.TimezoneFieldSymbols[*el.Timezone])
 	}
 }
 
 func (b *Builder) doExtractSource(
 	el *grammar.ExtractSource,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Datetime != nil {
-		b.doDatetimeValueExpression(el.Datetime, qargs, curarg)
+		b.doDatetimeValueExpression(el.Datetime)
 	} else if el.Interval != nil {
-		b.doIntervalValueExpression(el.Interval, qargs, curarg)
+		b.doIntervalValueExpression(el.Interval)
 	}
 }
 
 func (b *Builder) doNaturalLogarithm(
 	el *grammar.NaturalLogarithm,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Ln)
+	b.doFunctionName(symbol.Ln)
 	b.WriteString(symbol.LeftParen)
-	b.doNumericValueExpression(&el.Subject, qargs, curarg)
+	b.doNumericValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doAbsoluteValueExpression(
 	el *grammar.AbsoluteValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Abs)
+	b.doFunctionName(symbol.Abs)
 	b.WriteString(symbol.LeftParen)
-	b.doNumericValueExpression(&el.Subject, qargs, curarg)
+	b.doNumericValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doExponentialFunction(
 	el *grammar.ExponentialFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Exp)
+	b.doFunctionName(symbol.Exp)
 	b.WriteString(symbol.LeftParen)
-	b.doNumericValueExpression(&el.Subject, qargs, curarg)
+	b.doNumericValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doSquareRoot(
 	el *grammar.SquareRoot,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Sqrt)
+	b.doFunctionName(symbol.Sqrt)
 	b.WriteString(symbol.LeftParen)
-	b.doNumericValueExpression(&el.Subject, qargs, curarg)
+	b.doNumericValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doCeilingFunction(
 	el *grammar.CeilingFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Ceil)
+	b.doFunctionName(symbol.Ceil)
 	b.WriteString(symbol.LeftParen)
-	b.doNumericValueExpression(&el.Subject, qargs, curarg)
+	b.doNumericValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doFloorFunction(
 	el *grammar.FloorFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Floor)
+	b.doFunctionName(symbol.Floor)
 	b.WriteString(symbol.LeftParen)
-	b.doNumericValueExpression(&el.Subject, qargs, curarg)
+	b.doNumericValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
@@ -13,19 +13,35 @@ import (
 
 func (b *Builder) doOrderByClause(
 	el *grammar.OrderByClause,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Order)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Order)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.By)
+	b.doKeyword(symbol.By)
 	b.WriteString(symbol.Space)
 	for x, ss := range el.SortSpecifications {
 		if x > 0 {
 			b.WriteString(symbol.Comma)
 			b.WriteString(symbol.Space)
 		}
-		b.doSortSpecification(&ss, qargs, curarg)
+		b.doSortSpecification(&ss)
 	}
 }
+
+// doUnorderedOrderByClause outputs an ORDER BY clause that imposes no
+// particular order on the rows. T-SQL requires an ORDER BY clause when an
+// OFFSET and FETCH clause is used.
+//
+// ORDER BY (SELECT NULL)
+func (b *Builder) doUnorderedOrderByClause() {
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Order)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.By)
+	b.WriteString(symbol.Space)
+	b.WriteString(symbol.LeftParen)
+	b.doKeyword(symbol.Select)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Null)
+	b.WriteString(symbol.RightParen)
+}
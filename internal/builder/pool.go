@@ -0,0 +1,121 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// pool holds Builders that have been released for reuse
+var pool = sync.Pool{
+	New: func() interface{} {
+		return &Builder{}
+	},
+}
+
+// Acquire returns a Builder from a pool of Builders, ready to build a target
+// with the supplied Options. Call Release once done with the Builder.
+//
+// Reusing Builders avoids allocating a Builder, and the dialect's numbered
+// placeholders, for every target built.
+func Acquire(
+	mods ...types.Option,
+) *Builder {
+	b := pool.Get().(*Builder)
+	b.reset(types.MergeOptions(mods))
+	return b
+}
+
+// Release returns the Builder to the pool of Builders. The SQL string and
+// query args already returned by the Builder remain valid, but the Builder
+// must not be used again.
+func (b *Builder) Release() {
+	// Drop the references to the returned SQL string and query args, and to
+	// the target, so that the pool does not keep them alive
+	b.Builder.Reset()
+	b.args = nil
+	b.top = nil
+	b.scopes = b.scopes[:0]
+	b.lastScope = nil
+	pool.Put(b)
+}
+
+// shape holds estimates of the size of the SQL string and the number of
+// query args of the statements recently built for one kind of statement,
+// e.g. all SELECTs. The buffer and query args of the next statement of the
+// same kind are pre-sized from them, which avoids repeatedly growing the
+// buffer while building.
+//
+// The estimates are shared by every statement of the kind, however different
+// the statements are, so they only ever approximate the next statement's
+// size. They are capped, and shrink gradually after a large statement, so
+// that one very large statement does not make every following Builder
+// allocate a buffer that large.
+type shape struct {
+	size atomic.Int64
+	args atomic.Int64
+}
+
+const (
+	// maxShapeSize is the largest estimated size of a SQL string
+	maxShapeSize = 16 << 10
+	// maxShapeArgs is the largest estimated number of query args
+	maxShapeArgs = 256
+)
+
+// record updates the estimates with the size of the SQL string and the
+// number of query args of a statement that was just built. Concurrent
+// Builders may overwrite each other's updates, which is harmless for an
+// estimate.
+func (s *shape) record(size, args int) {
+	s.size.Store(estimate(s.size.Load(), int64(size), maxShapeSize))
+	s.args.Store(estimate(s.args.Load(), int64(args), maxShapeArgs))
+}
+
+// estimate returns the new estimate given the previous estimate and the
+// supplied value. A larger value is adopted at once, up to the supplied
+// limit, while a smaller value only moves the estimate a quarter of the way
+// towards it.
+func estimate(prev, val, limit int64) int64 {
+	if val >= prev {
+		return min(val, limit)
+	}
+	return prev - (prev-val)/4
+}
+
+var (
+	shapeQuery    shape
+	shapeCursor   shape
+	shapeInsert   shape
+	shapeUpdate   shape
+	shapeDelete   shape
+	shapeTruncate shape
+)
+
+// shapeOf returns the shape shared by all statements of the same kind as the
+// supplied statement element, or nil if the element is not a statement
+func shapeOf(el interface{}) *shape {
+	switch el.(type) {
+	case *grammar.QuerySpecification:
+		return &shapeQuery
+	case *grammar.CursorSpecification:
+		return &shapeCursor
+	case *grammar.InsertStatement:
+		return &shapeInsert
+	case *grammar.UpdateStatementSearched:
+		return &shapeUpdate
+	case *grammar.DeleteStatementSearched:
+		return &shapeDelete
+	case *grammar.TruncateTableStatement:
+		return &shapeTruncate
+	}
+	return nil
+}
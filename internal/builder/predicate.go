@@ -9,30 +9,27 @@ package builder
 import (
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/core/types"
 )
 
 func (b *Builder) doPredicate(
 	el *grammar.Predicate,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Comparison != nil {
-		b.doComparisonPredicate(el.Comparison, qargs, curarg)
+		b.doComparisonPredicate(el.Comparison)
 	} else if el.In != nil {
-		b.doInPredicate(el.In, qargs, curarg)
+		b.doInPredicate(el.In)
 	} else if el.Between != nil {
-		b.doBetweenPredicate(el.Between, qargs, curarg)
+		b.doBetweenPredicate(el.Between)
 	} else if el.Null != nil {
-		b.doNullPredicate(el.Null, qargs, curarg)
+		b.doNullPredicate(el.Null)
 	}
 }
 
 func (b *Builder) doComparisonPredicate(
 	el *grammar.ComparisonPredicate,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doRowValuePredicand(&el.A, qargs, curarg)
+	b.doRowValuePredicand(&el.A)
 	switch el.Operator {
 	case grammar.ComparisonOperatorEquals:
 		b.WriteString(symbol.Space)
@@ -62,56 +59,59 @@ func (b *Builder) doComparisonPredicate(
 		b.WriteString(symbol.EqualsOperator)
 		b.WriteString(symbol.Space)
 	}
-	b.doRowValuePredicand(&el.B, qargs, curarg)
+	b.doRowValuePredicand(&el.B)
 }
 
 func (b *Builder) doInPredicate(
 	el *grammar.InPredicate,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doRowValuePredicand(&el.Target, qargs, curarg)
+	if b.validate && len(el.Values) == 0 {
+		b.invalid(types.EmptyInList)
+	}
+	b.doRowValuePredicand(&el.Target)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.In)
+	b.doKeyword(symbol.In)
 	b.WriteString(symbol.LeftParen)
+	if b.fingerprint && len(el.Values) > 0 && collapsesInList(el) {
+		// IN lists of any length have the same fingerprint
+		b.WriteString(symbol.QuestionMark)
+		b.WriteString(symbol.RightParen)
+		return
+	}
 	for x, rve := range el.Values {
 		if x > 0 {
 			b.WriteString(symbol.Comma)
 			b.WriteString(symbol.Space)
 		}
-		b.doNonParenthesizedValueExpressionPrimary(rve.Primary, qargs, curarg)
+		b.doNonParenthesizedValueExpressionPrimary(rve.Primary)
 	}
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doBetweenPredicate(
 	el *grammar.BetweenPredicate,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doRowValuePredicand(&el.Target, qargs, curarg)
+	b.doRowValuePredicand(&el.Target)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Between)
+	b.doKeyword(symbol.Between)
 	b.WriteString(symbol.Space)
-	b.doRowValuePredicand(&el.Start, qargs, curarg)
+	b.doRowValuePredicand(&el.Start)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.And)
+	b.doKeyword(symbol.And)
 	b.WriteString(symbol.Space)
-	b.doRowValuePredicand(&el.End, qargs, curarg)
+	b.doRowValuePredicand(&el.End)
 }
 
 func (b *Builder) doNullPredicate(
 	el *grammar.NullPredicate,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doRowValuePredicand(&el.Target, qargs, curarg)
+	b.doRowValuePredicand(&el.Target)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Is)
+	b.doKeyword(symbol.Is)
 	if el.Not {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Not)
+		b.doKeyword(symbol.Not)
 	}
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Null)
+	b.doKeyword(symbol.Null)
 }
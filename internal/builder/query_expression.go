@@ -13,57 +13,47 @@ import (
 
 func (b *Builder) doQueryExpression(
 	el *grammar.QueryExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	body := el.Body
 	if body.NonJoin != nil {
-		b.doNonJoinQueryExpression(body.NonJoin, qargs, curarg)
+		b.doNonJoinQueryExpression(body.NonJoin)
 	} else if body.Joined != nil {
-		b.doJoinedTable(body.Joined, qargs, curarg)
+		b.doJoinedTable(body.Joined)
 	}
 }
 
 func (b *Builder) doNonJoinQueryExpression(
 	el *grammar.NonJoinQueryExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.NonJoin != nil {
-		b.doNonJoinQueryTerm(el.NonJoin, qargs, curarg)
+		b.doNonJoinQueryTerm(el.NonJoin)
 	}
 }
 
 func (b *Builder) doNonJoinQueryTerm(
 	el *grammar.NonJoinQueryTerm,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doNonJoinQueryPrimary(el.Primary, qargs, curarg)
+		b.doNonJoinQueryPrimary(el.Primary)
 	}
 }
 
 func (b *Builder) doNonJoinQueryPrimary(
 	el *grammar.NonJoinQueryPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Simple != nil {
-		b.doSimpleTable(el.Simple, qargs, curarg)
+		b.doSimpleTable(el.Simple)
 	} else if el.Parenthesized != nil {
 		b.WriteString(symbol.LeftParen)
-		b.doNonJoinQueryExpression(el.Parenthesized, qargs, curarg)
+		b.doNonJoinQueryExpression(el.Parenthesized)
 		b.WriteString(symbol.RightParen)
 	}
 }
 
 func (b *Builder) doSimpleTable(
 	el *grammar.SimpleTable,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.QuerySpecification != nil {
-		b.doQuerySpecification(el.QuerySpecification, qargs, curarg)
+		b.doQuerySpecification(el.QuerySpecification)
 	}
 }
@@ -13,11 +13,19 @@ import (
 
 func (b *Builder) doQuerySpecification(
 	el *grammar.QuerySpecification,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Select)
-	b.WriteString(symbol.Space)
-	b.doSelectList(&el.SelectList, qargs, curarg)
-	b.doTableExpression(&el.TableExpression, qargs, curarg)
+	b.pushScope(el.TableExpression.From.TableReferences)
+	defer b.popScope()
+	b.doKeyword(symbol.Select)
+	if b.top != nil {
+		top := b.top
+		b.top = nil
+		b.WriteString(symbol.Space)
+		b.doTopClause(top)
+	}
+	if el.SelectList.Asterisk || !b.opts.FormatPretty() {
+		b.WriteString(symbol.Space)
+	}
+	b.doSelectList(&el.SelectList)
+	b.doTableExpression(&el.TableExpression)
 }
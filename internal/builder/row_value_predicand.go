@@ -12,14 +12,12 @@ import (
 
 func (b *Builder) doRowValuePredicand(
 	el *grammar.RowValuePredicand,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doNonParenthesizedValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doNonParenthesizedValueExpressionPrimary(el.Primary)
 	} else if el.Common != nil {
-		b.doCommonValueExpression(el.Common, qargs, curarg)
+		b.doCommonValueExpression(el.Common)
 	} else if el.Boolean != nil {
-		b.doBooleanPredicand(el.Boolean, qargs, curarg)
+		b.doBooleanPredicand(el.Boolean)
 	}
 }
@@ -6,12 +6,38 @@
 
 package builder
 
+import (
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+)
+
+// doScalar outputs the placeholder for the supplied query arg and records the
+// query arg's value, or outputs the value as a SQL literal if the Options'
+// InlineArgs is set. When fingerprinting, the placeholder is always ?.
 func (b *Builder) doScalar(
 	el interface{},
-	qargs []interface{},
-	curarg *int,
 ) {
-	qargs[*curarg] = el
-	b.WriteString(InterpolationMarker(b.opts, *curarg))
-	*curarg++
+	b.checkParam(el)
+	position := len(b.args)
+	b.args = append(b.args, el)
+	switch {
+	case b.fingerprint:
+		b.WriteString(symbol.QuestionMark)
+	case b.opts.InlineArgs():
+		b.doInlineArg(el)
+	default:
+		b.WriteString(b.placeholder(position))
+	}
+}
+
+// placeholder returns the Dialect's placeholder for the query arg at the
+// supplied position. Placeholders are cached by the Builder, and so by the
+// pool of Builders, so that numbered placeholders like $1 are not allocated
+// again for every query arg of every query.
+func (b *Builder) placeholder(position int) string {
+	for len(b.placeholders) <= position {
+		b.placeholders = append(
+			b.placeholders, b.dialect.Placeholder(len(b.placeholders)),
+		)
+	}
+	return b.placeholders[position]
 }
@@ -13,30 +13,34 @@ import (
 
 func (b *Builder) doSelectList(
 	el *grammar.SelectList,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Asterisk {
 		b.WriteString(symbol.Asterisk)
 	} else {
+		pretty := b.opts.FormatPretty()
 		for x, s := range el.Sublists {
 			if x > 0 {
 				b.WriteString(symbol.Comma)
-				b.WriteString(symbol.Space)
+				if !pretty {
+					b.WriteString(symbol.Space)
+				}
 			}
-			b.doSelectSublist(&s, qargs, curarg)
+			if pretty {
+				// Each select-list item goes on its own line, indented
+				// beneath the SELECT keyword
+				b.doLineBreak(b.depth + 1)
+			}
+			b.doSelectSublist(&s)
 		}
 	}
 }
 
 func (b *Builder) doSelectSublist(
 	el *grammar.SelectSublist,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Asterisk {
 		// TODO(jaypipes): Handle qualified asterisk expression
 	} else {
-		b.doDerivedColumn(el.DerivedColumn, qargs, curarg)
+		b.doDerivedColumn(el.DerivedColumn)
 	}
 }
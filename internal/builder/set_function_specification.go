@@ -12,10 +12,8 @@ import (
 
 func (b *Builder) doSetFunctionSpecification(
 	el *grammar.SetFunctionSpecification,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Aggregate != nil {
-		b.doAggregateFunction(el.Aggregate, qargs, curarg)
+		b.doAggregateFunction(el.Aggregate)
 	}
 }
@@ -13,16 +13,14 @@ import (
 
 func (b *Builder) doSortSpecification(
 	el *grammar.SortSpecification,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doValueExpression(&el.Key, qargs, curarg)
+	b.doValueExpression(&el.Key)
 	if el.Order == grammar.OrderSpecificationDesc {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Desc)
+		b.doKeyword(symbol.Desc)
 	}
 	if el.NullOrder != grammar.NullOrderSpecificationNone {
 		b.WriteString(symbol.Space)
-		b.WriteString(grammar.NullOrderSpecificationSymbol[el.NullOrder])
+		b.doKeyword(grammar.NullOrderSpecificationSymbol[el.NullOrder])
 	}
 }
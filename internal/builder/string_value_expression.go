@@ -13,44 +13,78 @@ import (
 
 func (b *Builder) doStringValueExpression(
 	el *grammar.StringValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Character != nil {
-		b.doCharacterValueExpression(el.Character, qargs, curarg)
+		b.doCharacterValueExpression(el.Character)
 	} else if el.Blob != nil {
-		b.doBlobValueExpression(el.Blob, qargs, curarg)
+		b.doBlobValueExpression(el.Blob)
 	}
 }
 
 func (b *Builder) doCharacterValueExpression(
 	el *grammar.CharacterValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
-	if el.Factor != nil {
-		b.doCharacterFactor(el.Factor, qargs, curarg)
+	if el.Concatenation != nil {
+		b.doConcatenation(el.Concatenation)
+	} else if el.Factor != nil {
+		b.doCharacterFactor(el.Factor)
+	}
+}
+
+// doConcatenation outputs the supplied concatenation of character values.
+// MySQL treats "||" as a logical OR operator unless the PIPES_AS_CONCAT SQL
+// mode is enabled, so we output the CONCAT() function for MySQL. T-SQL uses
+// the "+" operator. Other dialects use the standard "||" operator.
+func (b *Builder) doConcatenation(
+	el *grammar.Concatenation,
+) {
+	factors := concatenationFactors(el)
+	op := b.dialect.ConcatenationOperator()
+	sep := symbol.Space + op + symbol.Space
+	if op == "" {
+		b.doKeyword(symbol.Concat)
+		b.WriteString(symbol.LeftParen)
+		sep = symbol.Comma + symbol.Space
+	}
+	for x, f := range factors {
+		if x > 0 {
+			b.WriteString(sep)
+		}
+		b.doCharacterFactor(f)
+	}
+	if op == "" {
+		b.WriteString(symbol.RightParen)
+	}
+}
+
+// concatenationFactors returns the character factors of the supplied
+// left-recursive concatenation, in order
+func concatenationFactors(
+	el *grammar.Concatenation,
+) []*grammar.CharacterFactor {
+	factors := []*grammar.CharacterFactor{}
+	if el.Left.Concatenation != nil {
+		factors = append(factors, concatenationFactors(el.Left.Concatenation)...)
+	} else if el.Left.Factor != nil {
+		factors = append(factors, el.Left.Factor)
 	}
+	return append(factors, &el.Right)
 }
 
 func (b *Builder) doBlobValueExpression(
 	el *grammar.BlobValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Factor != nil {
-		b.doBlobFactor(el.Factor, qargs, curarg)
+		b.doBlobFactor(el.Factor)
 	}
 }
 
 func (b *Builder) doCharacterFactor(
 	el *grammar.CharacterFactor,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doCharacterPrimary(&el.Primary, qargs, curarg)
+	b.doCharacterPrimary(&el.Primary)
 	if el.Collation != nil {
-		b.WriteString(symbol.Collate)
+		b.doKeyword(symbol.Collate)
 		b.WriteString(symbol.Space)
 		b.WriteString(*el.Collation)
 	}
@@ -58,32 +92,26 @@ func (b *Builder) doCharacterFactor(
 
 func (b *Builder) doCharacterPrimary(
 	el *grammar.CharacterPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doValueExpressionPrimary(el.Primary)
 	} else if el.Function != nil {
-		b.doStringValueFunction(el.Function, qargs, curarg)
+		b.doStringValueFunction(el.Function)
 	}
 }
 
 func (b *Builder) doBlobFactor(
 	el *grammar.BlobFactor,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doBlobPrimary(&el.Primary, qargs, curarg)
+	b.doBlobPrimary(&el.Primary)
 }
 
 func (b *Builder) doBlobPrimary(
 	el *grammar.BlobPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doValueExpressionPrimary(el.Primary)
 	} else if el.Function != nil {
-		b.doStringValueFunction(el.Function, qargs, curarg)
+		b.doStringValueFunction(el.Function)
 	}
 }
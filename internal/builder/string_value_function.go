@@ -7,199 +7,265 @@
 package builder
 
 import (
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
+const (
+	// ltrim and rtrim are the non-standard functions that trim leading and
+	// trailing characters from a string
+	ltrim = "LTRIM"
+	rtrim = "RTRIM"
+	// maxStringLength is the largest string length, in characters, that all
+	// dialects accept as a function argument
+	maxStringLength = "2147483647"
+)
+
 func (b *Builder) doStringValueFunction(
 	el *grammar.StringValueFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Character != nil {
-		b.doCharacterValueFunction(el.Character, qargs, curarg)
+		b.doCharacterValueFunction(el.Character)
 	} else if el.Blob != nil {
-		b.doBlobValueFunction(el.Blob, qargs, curarg)
+		b.doBlobValueFunction(el.Blob)
 	}
 }
 
 func (b *Builder) doCharacterValueFunction(
 	el *grammar.CharacterValueFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Substring != nil {
-		b.doCharacterSubstringFunction(el.Substring, qargs, curarg)
+		b.doCharacterSubstringFunction(el.Substring)
 	} else if el.RegexSubstring != nil {
-		b.doRegexSubstringFunction(el.RegexSubstring, qargs, curarg)
+		b.doRegexSubstringFunction(el.RegexSubstring)
 	} else if el.Fold != nil {
-		b.doFoldFunction(el.Fold, qargs, curarg)
+		b.doFoldFunction(el.Fold)
 	} else if el.Transcoding != nil {
-		b.doTranscodingFunction(el.Transcoding, qargs, curarg)
+		b.doTranscodingFunction(el.Transcoding)
 	} else if el.Transliteration != nil {
-		b.doCharacterTransliterationFunction(el.Transliteration, qargs, curarg)
+		b.doCharacterTransliterationFunction(el.Transliteration)
 	} else if el.Trim != nil {
-		b.doTrimFunction(el.Trim, qargs, curarg)
+		b.doTrimFunction(el.Trim)
 	} else if el.Overlay != nil {
-		b.doCharacterOverlayFunction(el.Overlay, qargs, curarg)
+		b.doCharacterOverlayFunction(el.Overlay)
 	} else if el.Normalize != nil {
-		b.doNormalizeFunction(el.Normalize, qargs, curarg)
+		b.doNormalizeFunction(el.Normalize)
 	}
 }
 
 func (b *Builder) doBlobValueFunction(
 	el *grammar.BlobValueFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
 }
 
 func (b *Builder) doCharacterSubstringFunction(
 	el *grammar.CharacterSubstringFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Substring)
+	if b.functionSyntax(symbol.Substring) == dialect.FunctionSyntaxArguments {
+		if el.Using != grammar.CharacterLengthUnitsCharacters {
+			b.unsupported(
+				symbol.Substring + " " + symbol.Using + " " +
+					grammar.CharacterLengthUnitsSymbol[el.Using],
+			)
+		}
+		b.doFunctionCall(
+			symbol.Substring,
+			func() { b.doCharacterValueExpression(&el.Subject) },
+			func() { b.doNumericValueExpression(&el.From) },
+			func() {
+				// Not all dialects accept a SUBSTRING() call without a
+				// length, so we output the largest string length instead
+				if el.For == nil {
+					b.WriteString(maxStringLength)
+					return
+				}
+				b.doNumericValueExpression(el.For)
+			},
+		)
+		return
+	}
+	b.doKeyword(symbol.Substring)
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.From)
+	b.doKeyword(symbol.From)
 	b.WriteString(symbol.Space)
-	b.doNumericValueExpression(&el.From, qargs, curarg)
+	b.doNumericValueExpression(&el.From)
 	if el.For != nil {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.For)
+		b.doKeyword(symbol.For)
 		b.WriteString(symbol.Space)
-		b.doNumericValueExpression(el.For, qargs, curarg)
+		b.doNumericValueExpression(el.For)
 	}
 	if el.Using != grammar.CharacterLengthUnitsCharacters {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Using)
+		b.doKeyword(symbol.Using)
 		b.WriteString(symbol.Space)
-		b.WriteString(grammar.CharacterLengthUnitsSymbol[el.Using])
+		b.doKeyword(grammar.CharacterLengthUnitsSymbol[el.Using])
 	}
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doRegexSubstringFunction(
 	el *grammar.RegexSubstringFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Substring)
+	b.functionSyntax(symbol.Similar)
+	b.doKeyword(symbol.Substring)
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Similar)
+	b.doKeyword(symbol.Similar)
 	b.WriteString(symbol.Space)
-	b.doCharacterValueExpression(&el.Similar, qargs, curarg)
+	b.doCharacterValueExpression(&el.Similar)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Escape)
+	b.doKeyword(symbol.Escape)
 	b.WriteString(symbol.Space)
-	b.doCharacterValueExpression(&el.Escape, qargs, curarg)
+	b.doCharacterValueExpression(&el.Escape)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doFoldFunction(
 	el *grammar.FoldFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(grammar.FoldCaseSymbols[el.Case])
+	b.doFunctionName(grammar.FoldCaseSymbols[el.Case])
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doTranscodingFunction(
 	el *grammar.TranscodingFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Convert)
+	b.doFunctionName(symbol.Convert)
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Using)
+	b.doKeyword(symbol.Using)
 	b.WriteString(symbol.Space)
-	b.doSchemaQualifiedName(&el.Using, qargs, curarg)
+	b.doSchemaQualifiedName(&el.Using)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doCharacterTransliterationFunction(
 	el *grammar.CharacterTransliterationFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Translate)
+	b.doFunctionName(symbol.Translate)
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Using)
+	b.doKeyword(symbol.Using)
 	b.WriteString(symbol.Space)
-	b.doSchemaQualifiedName(&el.Using, qargs, curarg)
+	b.doSchemaQualifiedName(&el.Using)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doTrimFunction(
 	el *grammar.TrimFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Trim)
+	if b.functionSyntax(symbol.Trim) == dialect.FunctionSyntaxArguments {
+		// TRIM(x, c), LTRIM(x, c) or RTRIM(x, c)
+		name := symbol.Trim
+		switch el.Specification {
+		case grammar.TrimSpecificationLeading:
+			name = ltrim
+		case grammar.TrimSpecificationTrailing:
+			name = rtrim
+		}
+		args := []func(){
+			func() { b.doCharacterValueExpression(&el.Subject) },
+		}
+		if el.Character != nil {
+			args = append(args, func() {
+				b.doCharacterValueExpression(el.Character)
+			})
+		}
+		b.doFunctionCall(name, args...)
+		return
+	}
+	if el.Specification != grammar.TrimSpecificationBoth &&
+		!b.supports(dialect.FeatureTrimSpecification) {
+		b.unsupported(
+			symbol.Trim + " " +
+				grammar.TrimSpecificationSymbols[el.Specification],
+		)
+	}
+	b.doKeyword(symbol.Trim)
 	b.WriteString(symbol.LeftParen)
 	if el.Specification != grammar.TrimSpecificationBoth {
-		b.WriteString(grammar.TrimSpecificationSymbols[el.Specification])
+		b.doKeyword(grammar.TrimSpecificationSymbols[el.Specification])
 		b.WriteString(symbol.Space)
 	}
 	if el.Character != nil {
-		b.doCharacterValueExpression(el.Character, qargs, curarg)
+		b.doCharacterValueExpression(el.Character)
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.From)
+		b.doKeyword(symbol.From)
 		b.WriteString(symbol.Space)
 	}
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doCharacterOverlayFunction(
 	el *grammar.CharacterOverlayFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Overlay)
+	if b.functionSyntax(symbol.Overlay) == dialect.FunctionSyntaxArguments {
+		// INSERT(x, from, for, placing) or STUFF(x, from, for, placing). The
+		// replaced length defaults to the length of the placed string, which
+		// cannot be output without outputting its query args twice.
+		if el.For == nil {
+			b.unsupported(symbol.Overlay + " without " + symbol.For)
+		}
+		if el.Using != grammar.CharacterLengthUnitsCharacters {
+			b.unsupported(
+				symbol.Overlay + " " + symbol.Using + " " +
+					grammar.CharacterLengthUnitsSymbol[el.Using],
+			)
+		}
+		b.doFunctionCall(
+			symbol.Overlay,
+			func() { b.doCharacterValueExpression(&el.Subject) },
+			func() { b.doNumericValueExpression(&el.From) },
+			func() {
+				if el.For != nil {
+					b.doNumericValueExpression(el.For)
+				}
+			},
+			func() { b.doCharacterValueExpression(&el.Placing) },
+		)
+		return
+	}
+	b.doKeyword(symbol.Overlay)
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Placing)
+	b.doKeyword(symbol.Placing)
 	b.WriteString(symbol.Space)
-	b.doCharacterValueExpression(&el.Placing, qargs, curarg)
+	b.doCharacterValueExpression(&el.Placing)
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.From)
+	b.doKeyword(symbol.From)
 	b.WriteString(symbol.Space)
-	b.doNumericValueExpression(&el.From, qargs, curarg)
+	b.doNumericValueExpression(&el.From)
 	if el.For != nil {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.For)
+		b.doKeyword(symbol.For)
 		b.WriteString(symbol.Space)
-		b.doNumericValueExpression(el.For, qargs, curarg)
+		b.doNumericValueExpression(el.For)
 	}
 	if el.Using != grammar.CharacterLengthUnitsCharacters {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.Using)
+		b.doKeyword(symbol.Using)
 		b.WriteString(symbol.Space)
-		b.WriteString(grammar.CharacterLengthUnitsSymbol[el.Using])
+		b.doKeyword(grammar.CharacterLengthUnitsSymbol[el.Using])
 	}
 	b.WriteString(symbol.RightParen)
 }
 
 func (b *Builder) doNormalizeFunction(
 	el *grammar.NormalizeFunction,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Normalize)
+	b.doFunctionName(symbol.Normalize)
 	b.WriteString(symbol.LeftParen)
-	b.doCharacterValueExpression(&el.Subject, qargs, curarg)
+	b.doCharacterValueExpression(&el.Subject)
 	b.WriteString(symbol.RightParen)
 }
@@ -8,15 +8,12 @@ package builder
 
 import (
 	"github.com/jaypipes/sqlb/core/grammar"
-	"github.com/jaypipes/sqlb/core/grammar/symbol"
 )
 
 func (b *Builder) doSubquery(
 	el *grammar.Subquery,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.LeftParen)
-	b.doQueryExpression(&el.QueryExpression, qargs, curarg)
-	b.WriteString(symbol.RightParen)
+	b.doSubqueryOpen()
+	b.doQueryExpression(&el.QueryExpression)
+	b.doSubqueryClose()
 }
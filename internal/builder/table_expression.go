@@ -12,17 +12,15 @@ import (
 
 func (b *Builder) doTableExpression(
 	el *grammar.TableExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.doFromClause(&el.From, qargs, curarg)
+	b.doFromClause(&el.From)
 	if el.Where != nil {
-		b.doWhereClause(el.Where, qargs, curarg)
+		b.doWhereClause(el.Where)
 	}
 	if el.GroupBy != nil {
-		b.doGroupByClause(el.GroupBy, qargs, curarg)
+		b.doGroupByClause(el.GroupBy)
 	}
 	if el.Having != nil {
-		b.doHavingClause(el.Having, qargs, curarg)
+		b.doHavingClause(el.Having)
 	}
 }
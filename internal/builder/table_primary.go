@@ -13,20 +13,18 @@ import (
 
 func (b *Builder) doTablePrimary(
 	el *grammar.TablePrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.TableName != nil {
-		b.WriteString(*el.TableName)
+		b.doTableName(el.SchemaName, *el.TableName)
 	} else if el.QueryName != nil {
-		b.WriteString(*el.QueryName)
+		b.doIdentifier(*el.QueryName)
 	} else if el.DerivedTable != nil {
-		b.doDerivedTable(el.DerivedTable, qargs, curarg)
+		b.doDerivedTable(el.DerivedTable)
 	}
 	if el.Correlation != nil {
 		b.WriteString(symbol.Space)
-		b.WriteString(symbol.As)
+		b.doKeyword(symbol.As)
 		b.WriteString(symbol.Space)
-		b.WriteString(el.Correlation.Name)
+		b.doIdentifier(el.Correlation.Name)
 	}
 }
@@ -12,12 +12,10 @@ import (
 
 func (b *Builder) doTableReference(
 	el *grammar.TableReference,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Primary != nil {
-		b.doTablePrimary(el.Primary, qargs, curarg)
+		b.doTablePrimary(el.Primary)
 	} else if el.Joined != nil {
-		b.doJoinedTable(el.Joined, qargs, curarg)
+		b.doJoinedTable(el.Joined)
 	}
 }
@@ -0,0 +1,151 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/scan"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// Template is a SQL statement that has been built once and can be executed
+// many times with different values for its named parameters. A Template is
+// safe for concurrent use by multiple goroutines.
+type Template struct {
+	qs string
+	// qargs contains the query args of the built statement, with a
+	// *grammar.HostParameterSpecification in place of each named parameter
+	qargs []interface{}
+	// params contains the distinct names of the named parameters, in the
+	// order in which they first appear in the statement
+	params []string
+}
+
+// Compile builds the supplied target, which may be anything accepted by
+// Builder.Build, into a Template. Values created with `expr.Param` are output
+// as placeholders whose values are supplied by the Template's Args or Bind
+// methods.
+func Compile(
+	target interface{},
+	opts ...types.Option,
+) (*Template, error) {
+	b := Acquire(opts...)
+	defer b.Release()
+	b.compiling = true
+	qs, qargs, err := b.Build(target)
+	if err != nil {
+		return nil, err
+	}
+	t := &Template{qs: qs, qargs: qargs}
+	seen := map[string]bool{}
+	for _, arg := range qargs {
+		p, ok := arg.(*grammar.HostParameterSpecification)
+		if !ok || seen[p.Name] {
+			continue
+		}
+		seen[p.Name] = true
+		t.params = append(t.params, p.Name)
+	}
+	return t, nil
+}
+
+// String returns the Template's SQL string
+func (t *Template) String() string {
+	return t.qs
+}
+
+// Params returns the names of the Template's named parameters, in the order
+// in which they first appear in the SQL string
+func (t *Template) Params() []string {
+	return append([]string(nil), t.params...)
+}
+
+// Args returns the query args to execute the Template's SQL string with,
+// taking the value of each named parameter from the supplied map. A named
+// parameter that appears more than once in the SQL string has its value
+// repeated for each placeholder.
+//
+// If the map has no value for one of the Template's named parameters, an
+// error wrapping types.MissingParam is returned. If the map has a value for a
+// name that is not one of the Template's named parameters, an error wrapping
+// types.UnknownParam is returned.
+func (t *Template) Args(
+	params map[string]interface{},
+) ([]interface{}, error) {
+	var unknown []string
+	for name := range params {
+		if !t.hasParam(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf(
+			"%w: %s", types.UnknownParam, strings.Join(unknown, ", "),
+		)
+	}
+	return t.args(func(name string) (interface{}, bool) {
+		v, ok := params[name]
+		return v, ok
+	})
+}
+
+// Bind returns the query args to execute the Template's SQL string with,
+// taking the value of each named parameter from the field of the supplied
+// struct, or pointer to a struct, that the `core/scan` package would scan a
+// column of the same name into. Parameter names are matched to fields
+// case-insensitively, and fields that do not match a named parameter are
+// ignored.
+//
+// If the struct has no field for one of the Template's named parameters, an
+// error wrapping types.MissingParam is returned.
+func (t *Template) Bind(src interface{}) ([]interface{}, error) {
+	vals, err := scan.Values(src)
+	if err != nil {
+		return nil, err
+	}
+	return t.args(func(name string) (interface{}, bool) {
+		v, ok := vals[strings.ToLower(name)]
+		return v, ok
+	})
+}
+
+// hasParam returns true if the Template has a named parameter with the
+// supplied name
+func (t *Template) hasParam(name string) bool {
+	for _, p := range t.params {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// args returns a copy of the Template's query args with each named parameter
+// replaced by the value returned by the supplied lookup function
+func (t *Template) args(
+	lookup func(name string) (interface{}, bool),
+) ([]interface{}, error) {
+	qargs := make([]interface{}, len(t.qargs))
+	for x, arg := range t.qargs {
+		p, ok := arg.(*grammar.HostParameterSpecification)
+		if !ok {
+			qargs[x] = arg
+			continue
+		}
+		v, found := lookup(p.Name)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", types.MissingParam, p.Name)
+		}
+		qargs[x] = v
+	}
+	return qargs, nil
+}
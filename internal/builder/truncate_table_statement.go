@@ -0,0 +1,50 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"github.com/jaypipes/sqlb/core/dialect"
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/grammar/symbol"
+)
+
+func (b *Builder) doTruncateTableStatement(
+	el *grammar.TruncateTableStatement,
+) {
+	if !b.supports(dialect.FeatureTruncate) {
+		// SQLite has no TRUNCATE statement. Instead, its query planner
+		// applies a "truncate optimization" to a DELETE without a WHERE
+		// clause.
+		b.doKeyword(symbol.Delete)
+		b.WriteString(symbol.Space)
+		b.doKeyword(symbol.From)
+		b.WriteString(symbol.Space)
+		b.doTableName(el.SchemaName, el.TableName)
+		return
+	}
+	b.doKeyword(symbol.Truncate)
+	b.WriteString(symbol.Space)
+	b.doKeyword(symbol.Table)
+	b.WriteString(symbol.Space)
+	b.doTableName(el.SchemaName, el.TableName)
+	// MySQL and T-SQL always reset AUTO_INCREMENT and IDENTITY columns when
+	// truncating a table and do not support CASCADE, so we only output the
+	// options for dialects that support them.
+	if !b.supports(dialect.FeatureTruncateOptions) {
+		return
+	}
+	if el.RestartIdentity {
+		b.WriteString(symbol.Space)
+		b.doKeyword(symbol.Restart)
+		b.WriteString(symbol.Space)
+		b.doKeyword(symbol.Identity)
+	}
+	if el.Cascade {
+		b.WriteString(symbol.Space)
+		b.doKeyword(symbol.Cascade)
+	}
+}
@@ -7,21 +7,79 @@
 package builder
 
 import (
+	"github.com/jaypipes/sqlb/core/dialect"
 	"github.com/jaypipes/sqlb/core/grammar"
 	"github.com/jaypipes/sqlb/core/grammar/symbol"
+	"github.com/jaypipes/sqlb/internal/inspect"
 )
 
 func (b *Builder) doUpdateStatementSearched(
 	el *grammar.UpdateStatementSearched,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(symbol.Update)
+	b.pushScope(el.From, el.TableName)
+	defer b.popScope()
+	if len(el.From) > 0 {
+		b.doMultiTableUpdateStatement(el)
+		return
+	}
+	if el.Limit != nil && !b.supports(dialect.FeatureDMLOrderByLimit) {
+		switch {
+		case b.dialect.RowIdentifier() != "":
+			// Neither PostgreSQL nor SQLite (unless compiled with
+			// SQLITE_ENABLE_UPDATE_DELETE_LIMIT) support ORDER BY or LIMIT
+			// in an UPDATE statement, so we select the row identifiers of
+			// the rows to update in a subquery:
+			//
+			// UPDATE t SET a = $1 WHERE ctid IN (SELECT ctid FROM t WHERE ... ORDER BY ... LIMIT $2)
+			b.doKeyword(symbol.Update)
+			b.WriteString(symbol.Space)
+			b.doTableName(el.SchemaName, el.TableName)
+			b.doSetClauseList(el, "")
+			b.doLimitedRowsSubquery(
+				el.SchemaName, el.TableName, el.Where, el.OrderBy, el.Limit,
+			)
+			b.doReturningClause(el.Returning, "")
+			return
+		case b.dialect.LimitStyle() == dialect.LimitStyleTopOffsetFetch:
+			// T-SQL only supports TOP in an UPDATE statement without an
+			// ORDER BY, so we select the rows to update in a common table
+			// expression and update the CTE:
+			//
+			// WITH cte AS (SELECT TOP (?) * FROM t WHERE ... ORDER BY ...) UPDATE cte SET a = ?
+			b.doLimitedRowsCommonTableExpression(
+				el.SchemaName, el.TableName, el.Where, el.OrderBy, el.Limit,
+			)
+			b.doKeyword(symbol.Update)
+			b.WriteString(symbol.Space)
+			b.WriteString(limitedRowsCommonTableExpressionName)
+			b.doSetClauseList(el, "")
+			return
+		}
+	}
+	b.doKeyword(symbol.Update)
 	b.WriteString(symbol.Space)
 	// We don't add any table alias when outputting the table identifier
-	b.WriteString(el.TableName)
+	b.doTableName(el.SchemaName, el.TableName)
+	b.doSetClauseList(el, "")
+
+	if el.Where != nil {
+		b.doWhereClause(el.Where)
+	}
+	if b.supports(dialect.FeatureDMLOrderByLimit) {
+		b.doOrderByLimitClauses(el.OrderBy, el.Limit)
+	}
+	b.doReturningClause(el.Returning, "")
+}
+
+// doSetClauseList outputs the SET <set clause list> portion of an UPDATE
+// statement, followed by any T-SQL OUTPUT clause. If the supplied qualifier is
+// not empty, each column name is prefixed with the qualifier.
+func (b *Builder) doSetClauseList(
+	el *grammar.UpdateStatementSearched,
+	qualifier string,
+) {
 	b.WriteString(symbol.Space)
-	b.WriteString(symbol.Set)
+	b.doKeyword(symbol.Set)
 	b.WriteString(symbol.Space)
 
 	for x, c := range el.Columns {
@@ -31,17 +89,82 @@ func (b *Builder) doUpdateStatementSearched(
 		}
 		// We don't add the table identifier or use an alias when outputting
 		// the column names in the <column_value_lists> element of the UPDATE
-		// statement
-		b.WriteString(c)
+		// statement unless the dialect requires it for multi-table UPDATEs
+		if qualifier != "" {
+			b.doIdentifier(qualifier)
+			b.WriteString(symbol.Period)
+		}
+		b.doIdentifier(c)
 		b.WriteString(symbol.Space)
 		b.WriteString(symbol.EqualsOperator)
 		b.WriteString(symbol.Space)
-		b.WriteString(InterpolationMarker(b.opts, *curarg))
-		qargs[*curarg] = el.Values[x]
-		*curarg++
+		b.doScalar(el.Values[x])
 	}
+	b.doOutputClause(el.Returning, symbol.Inserted)
+}
 
-	if el.Where != nil {
-		b.doWhereClause(el.Where, qargs, curarg)
+// doMultiTableUpdateStatement outputs an UPDATE statement that joins the
+// target table to one or more other tables. Each dialect has its own syntax
+// for this:
+//
+// MySQL:
+//
+// UPDATE t JOIN o ON t.a = o.b SET t.c = ? WHERE ...
+//
+// PostgreSQL and SQLite:
+//
+// UPDATE t SET c = ? FROM o WHERE t.a = o.b AND ...
+//
+// T-SQL:
+//
+// UPDATE t SET c = ? FROM t JOIN o ON t.a = o.b WHERE ...
+func (b *Builder) doMultiTableUpdateStatement(
+	el *grammar.UpdateStatementSearched,
+) {
+	prims, ons, _ := inspect.InnerJoinedTablePrimaries(el.From)
+	target := inspect.TablePrimaryByTableName(prims, el.TableName)
+	targetName := el.TableName
+	if target != nil && target.Correlation != nil {
+		targetName = target.Correlation.Name
+	}
+	b.doKeyword(symbol.Update)
+	b.WriteString(symbol.Space)
+	switch b.dialect.UpdateJoinStyle() {
+	case dialect.UpdateJoinStyleFrom:
+		if !b.supports(dialect.FeatureUpdateFrom) {
+			b.unsupportedFeature("UPDATE ... FROM")
+		}
+		if target != nil {
+			b.doTablePrimary(target)
+		} else {
+			b.doTableName(el.SchemaName, el.TableName)
+		}
+		b.doSetClauseList(el, "")
+		b.doClauseSeparator()
+		b.doKeyword(symbol.From)
+		b.WriteString(symbol.Space)
+		b.doTablePrimariesExcept(prims, target)
+		b.doSearchConditions(ons, el.Where)
+		b.doReturningClause(el.Returning, targetName)
+	case dialect.UpdateJoinStyleFromJoin:
+		b.doIdentifier(targetName)
+		b.doSetClauseList(el, "")
+		b.doFromClause(&grammar.FromClause{TableReferences: el.From})
+		if el.Where != nil {
+			b.doWhereClause(el.Where)
+		}
+	default:
+		for x, tr := range el.From {
+			if x > 0 {
+				b.WriteString(symbol.Comma)
+				b.WriteString(symbol.Space)
+			}
+			b.doTableReference(&tr)
+		}
+		b.doSetClauseList(el, targetName)
+		if el.Where != nil {
+			b.doWhereClause(el.Where)
+		}
+		b.doReturningClause(el.Returning, targetName)
 	}
 }
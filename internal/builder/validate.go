@@ -0,0 +1,143 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package builder
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/sqlb/core/grammar"
+	"github.com/jaypipes/sqlb/core/types"
+)
+
+// invalid records that the target being built is invalid. Only the first
+// such error is kept.
+func (b *Builder) invalid(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// pushScope makes the supplied relation names, and the names of the relations
+// in the supplied table references, available to qualify column references
+// until the matching popScope. Scopes are only tracked when validating.
+func (b *Builder) pushScope(
+	refs []grammar.TableReference,
+	names ...string,
+) {
+	if !b.validate {
+		return
+	}
+	// The names of every scope are stored in the Builder's scopeNames so
+	// that a pooled Builder can reuse their storage
+	start := len(b.scopeNames)
+	b.scopeNames = append(b.scopeNames, names...)
+	for x := range refs {
+		b.scopeNames = tableReferenceNames(b.scopeNames, &refs[x])
+	}
+	end := len(b.scopeNames)
+	b.scopes = append(b.scopes, b.scopeNames[start:end:end])
+}
+
+// popScope removes the innermost scope pushed by pushScope, remembering it as
+// the scope of the most recently built query so that a following ORDER BY
+// clause can refer to the query's relations
+func (b *Builder) popScope() {
+	if !b.validate || len(b.scopes) == 0 {
+		return
+	}
+	b.lastScope = b.scopes[len(b.scopes)-1]
+	b.scopes = b.scopes[:len(b.scopes)-1]
+}
+
+// tableReferenceNames appends the names that column references may use to
+// refer to the relations in the supplied table reference to the supplied
+// slice of names
+func tableReferenceNames(
+	names []string,
+	el *grammar.TableReference,
+) []string {
+	if el.Primary != nil {
+		return tablePrimaryNames(names, el.Primary)
+	}
+	if el.Joined == nil {
+		return names
+	}
+	j := el.Joined
+	switch {
+	case j.Qualified != nil:
+		names = tableReferenceNames(names, &j.Qualified.Left)
+		names = tableReferenceNames(names, &j.Qualified.Right)
+	case j.Natural != nil:
+		names = tableReferenceNames(names, &j.Natural.Left)
+		names = tablePrimaryNames(names, &j.Natural.Right)
+	case j.Cross != nil:
+		names = tableReferenceNames(names, &j.Cross.Left)
+		names = tablePrimaryNames(names, &j.Cross.Right)
+	case j.Union != nil:
+		names = tableReferenceNames(names, &j.Union.Left)
+		names = tablePrimaryNames(names, &j.Union.Right)
+	}
+	return names
+}
+
+// tablePrimaryNames appends the name that column references may use to refer
+// to the supplied table primary to the supplied slice of names. Once a table
+// is given a correlation name, it may only be referred to by that name.
+func tablePrimaryNames(
+	names []string,
+	el *grammar.TablePrimary,
+) []string {
+	if el.Correlation != nil {
+		return append(names, el.Correlation.Name)
+	}
+	if el.TableName != nil {
+		names = append(names, *el.TableName)
+	}
+	if el.QueryName != nil {
+		names = append(names, *el.QueryName)
+	}
+	return names
+}
+
+// checkColumnReference records an error if the supplied column reference is
+// qualified with the name of a relation that is not in any enclosing scope.
+// Correlated subqueries may refer to the relations of enclosing queries.
+func (b *Builder) checkColumnReference(el *grammar.ColumnReference) {
+	if !b.validate || len(b.scopes) == 0 || el.BasicIdentifierChain == nil {
+		return
+	}
+	ids := el.BasicIdentifierChain.Identifiers
+	if len(ids) < 2 {
+		return
+	}
+	qualifier := ids[len(ids)-2]
+	for _, scope := range b.scopes {
+		for _, name := range scope {
+			if name == qualifier {
+				return
+			}
+		}
+	}
+	b.invalid(fmt.Errorf(
+		"%w: %s", types.UnknownRelationReference, qualifier,
+	))
+}
+
+// checkParam records an error if the supplied query arg is a named parameter
+// created with `expr.Param` and the target is not being compiled into a
+// Template, since nothing would supply the named parameter's value
+func (b *Builder) checkParam(arg interface{}) {
+	if !b.validate || b.compiling {
+		return
+	}
+	if p, ok := arg.(*grammar.HostParameterSpecification); ok {
+		b.invalid(fmt.Errorf(
+			"%w: %s (use Compile to supply named parameters)",
+			types.MissingParam, p.Name,
+		))
+	}
+}
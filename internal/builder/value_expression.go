@@ -12,30 +12,26 @@ import (
 
 func (b *Builder) doValueExpression(
 	el *grammar.ValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Common != nil {
-		b.doCommonValueExpression(el.Common, qargs, curarg)
+		b.doCommonValueExpression(el.Common)
 	} else if el.Boolean != nil {
-		b.doBooleanValueExpression(el.Boolean, qargs, curarg)
+		b.doBooleanValueExpression(el.Boolean)
 	} else if el.Row != nil {
-		b.doNonParenthesizedValueExpressionPrimary(el.Row.Primary, qargs, curarg)
+		b.doNonParenthesizedValueExpressionPrimary(el.Row.Primary)
 	}
 }
 
 func (b *Builder) doCommonValueExpression(
 	el *grammar.CommonValueExpression,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Numeric != nil {
-		b.doNumericValueExpression(el.Numeric, qargs, curarg)
+		b.doNumericValueExpression(el.Numeric)
 	} else if el.String != nil {
-		b.doStringValueExpression(el.String, qargs, curarg)
+		b.doStringValueExpression(el.String)
 	} else if el.Datetime != nil {
-		b.doDatetimeValueExpression(el.Datetime, qargs, curarg)
+		b.doDatetimeValueExpression(el.Datetime)
 	} else if el.Interval != nil {
-		b.doIntervalValueExpression(el.Interval, qargs, curarg)
+		b.doIntervalValueExpression(el.Interval)
 	}
 }
@@ -13,30 +13,26 @@ import (
 
 func (b *Builder) doValueExpressionPrimary(
 	el *grammar.ValueExpressionPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.Parenthesized != nil {
 		b.WriteString(symbol.LeftParen)
-		b.doValueExpression(el.Parenthesized, qargs, curarg)
+		b.doValueExpression(el.Parenthesized)
 		b.WriteString(symbol.RightParen)
 	} else if el.Primary != nil {
-		b.doNonParenthesizedValueExpressionPrimary(el.Primary, qargs, curarg)
+		b.doNonParenthesizedValueExpressionPrimary(el.Primary)
 	}
 }
 
 func (b *Builder) doNonParenthesizedValueExpressionPrimary(
 	el *grammar.NonParenthesizedValueExpressionPrimary,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.UnsignedValue != nil {
-		b.doUnsignedValueSpecification(el.UnsignedValue, qargs, curarg)
+		b.doUnsignedValueSpecification(el.UnsignedValue)
 	} else if el.ColumnReference != nil {
-		b.doColumnReference(el.ColumnReference, qargs, curarg)
+		b.doColumnReference(el.ColumnReference)
 	} else if el.SetFunction != nil {
-		b.doSetFunctionSpecification(el.SetFunction, qargs, curarg)
+		b.doSetFunctionSpecification(el.SetFunction)
 	} else if el.ScalarSubquery != nil {
-		b.doSubquery(el.ScalarSubquery, qargs, curarg)
+		b.doSubquery(el.ScalarSubquery)
 	}
 }
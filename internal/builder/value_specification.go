@@ -12,10 +12,20 @@ import (
 
 func (b *Builder) doUnsignedValueSpecification(
 	el *grammar.UnsignedValueSpecification,
-	qargs []interface{},
-	curarg *int,
 ) {
 	if el.UnsignedLiteral != nil {
-		b.doUnsignedLiteral(el.UnsignedLiteral, qargs, curarg)
+		b.doUnsignedLiteral(el.UnsignedLiteral)
+	} else if el.GeneralValue != nil {
+		b.doGeneralValueSpecification(el.GeneralValue)
+	}
+}
+
+func (b *Builder) doGeneralValueSpecification(
+	el *grammar.GeneralValueSpecification,
+) {
+	if el.HostParameter != nil {
+		// The query arg for a host parameter is the parameter itself, which
+		// is replaced with the parameter's value by a Template
+		b.doScalar(el.HostParameter)
 	}
 }
@@ -13,11 +13,9 @@ import (
 
 func (b *Builder) doWhereClause(
 	el *grammar.WhereClause,
-	qargs []interface{},
-	curarg *int,
 ) {
-	b.WriteString(b.opts.FormatSeparateClauseWith())
-	b.WriteString(symbol.Where)
+	b.doClauseSeparator()
+	b.doKeyword(symbol.Where)
 	b.WriteString(symbol.Space)
-	b.doBooleanValueExpression(&el.Search, qargs, curarg)
+	b.doBooleanValueExpression(&el.Search)
 }
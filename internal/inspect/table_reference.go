@@ -70,3 +70,78 @@ func TableReferenceByName(
 	}
 	return nil
 }
+
+// InnerJoinedTablePrimaries walks the supplied TableReferences and returns
+// the TablePrimary elements contained in them along with the ON search
+// conditions of the inner joins between those TablePrimary elements. The
+// returned bool is false if any TableReference contains anything other than a
+// TablePrimary or an INNER qualified join.
+func InnerJoinedTablePrimaries(
+	refs []grammar.TableReference,
+) ([]*grammar.TablePrimary, []*grammar.BooleanValueExpression, bool) {
+	prims := []*grammar.TablePrimary{}
+	ons := []*grammar.BooleanValueExpression{}
+	for x := range refs {
+		ref := &refs[x]
+		if ref.Primary != nil {
+			prims = append(prims, ref.Primary)
+			continue
+		}
+		if ref.Joined == nil || ref.Joined.Qualified == nil {
+			return nil, nil, false
+		}
+		qj := ref.Joined.Qualified
+		if qj.Type != grammar.JoinTypeInner {
+			return nil, nil, false
+		}
+		lprims, lons, ok := InnerJoinedTablePrimaries(
+			[]grammar.TableReference{qj.Left, qj.Right},
+		)
+		if !ok {
+			return nil, nil, false
+		}
+		prims = append(prims, lprims...)
+		ons = append(ons, lons...)
+		ons = append(ons, &qj.On)
+	}
+	return prims, ons, true
+}
+
+// TablePrimaries walks the supplied TableReferences and returns all the
+// TablePrimary elements contained in them, including those on either side of
+// any qualified join.
+func TablePrimaries(
+	refs []grammar.TableReference,
+) []*grammar.TablePrimary {
+	prims := []*grammar.TablePrimary{}
+	for x := range refs {
+		ref := &refs[x]
+		if ref.Primary != nil {
+			prims = append(prims, ref.Primary)
+			continue
+		}
+		if ref.Joined == nil || ref.Joined.Qualified == nil {
+			continue
+		}
+		qj := ref.Joined.Qualified
+		prims = append(prims, TablePrimaries(
+			[]grammar.TableReference{qj.Left, qj.Right},
+		)...)
+	}
+	return prims
+}
+
+// TablePrimaryByTableName returns the first TablePrimary in the supplied
+// slice having a table name matching the supplied string, or nil if no such
+// TablePrimary exists.
+func TablePrimaryByTableName(
+	prims []*grammar.TablePrimary,
+	search string,
+) *grammar.TablePrimary {
+	for _, p := range prims {
+		if p.TableName != nil && *p.TableName == search {
+			return p
+		}
+	}
+	return nil
+}
@@ -21,6 +21,14 @@ func ValueSpecificationFromAny(
 		return &grammar.ValueSpecification{
 			UnsignedValue: v,
 		}
+	case *grammar.HostParameterSpecification:
+		return &grammar.ValueSpecification{
+			UnsignedValue: &grammar.UnsignedValueSpecification{
+				GeneralValue: &grammar.GeneralValueSpecification{
+					HostParameter: v,
+				},
+			},
+		}
 	case uint, uint8, uint16, uint64:
 		return &grammar.ValueSpecification{
 			UnsignedValue: &grammar.UnsignedValueSpecification{
@@ -67,6 +75,12 @@ func UnsignedValueSpecificationFromAny(
 		if v.UnsignedValue != nil {
 			return v.UnsignedValue
 		}
+	case *grammar.HostParameterSpecification:
+		return &grammar.UnsignedValueSpecification{
+			GeneralValue: &grammar.GeneralValueSpecification{
+				HostParameter: v,
+			},
+		}
 	case uint, uint8, uint16, uint64, int, int8, int16, int64:
 		return &grammar.UnsignedValueSpecification{
 			UnsignedLiteral: &grammar.UnsignedLiteral{
@@ -11,10 +11,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jaypipes/sqlb"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/internal/builder"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,16 +70,16 @@ func TestReflectMySQL(t *testing.T) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	meta, err := sqlb.Reflect(db)
+	m, err := sqlb.Reflect(db)
 	if err != nil {
 		log.Fatal(err)
 	}
 	require.Nil(t, err)
-	require.NotNil(t, meta)
-	assert.Equal(t, 3, len(meta.Tables))
-	assert.Equal(t, "sqlbtest", meta.Name)
+	require.NotNil(t, m)
+	assert.Equal(t, 5, len(m.Tables))
+	assert.Equal(t, "sqlbtest", m.Name)
 
-	users := meta.T("users")
+	users := m.T("users")
 	require.NotNil(t, users)
 	userCols := users.Projections()
 	assert.Equal(t, 3, len(userCols))
@@ -85,6 +88,96 @@ func TestReflectMySQL(t *testing.T) {
 	require.NotNil(t, userID)
 	assert.Equal(t, "id", userID.Name())
 	assert.Equal(t, users, userID.References())
+
+	col := userID.(*meta.Column)
+	assert.Equal(t, "int", col.DataType())
+	assert.False(t, col.Nullable())
+	assert.False(t, col.HasDefault())
+
+	col = users.C("name").(*meta.Column)
+	assert.Equal(t, "varchar", col.DataType())
+	assert.Equal(t, int64(100), col.Length())
+	assert.NotEmpty(t, col.Collation())
+
+	pk := users.PrimaryKey()
+	require.NotNil(t, pk)
+	assert.Equal(t, []string{"id"}, pk.Columns)
+	require.Len(t, users.UniqueConstraints(), 1)
+	assert.Equal(t, []string{"name"}, users.UniqueConstraints()[0].Columns)
+
+	articles := m.T("articles")
+	require.NotNil(t, articles)
+	require.Len(t, articles.ForeignKeys(), 1)
+	fk := articles.ForeignKeys()[0]
+	assert.Equal(t, []string{"author"}, fk.Columns)
+	assert.Equal(t, "users", fk.ReferencedTable)
+	assert.Equal(t, []string{"id"}, fk.ReferencedColumns)
+
+	idxs := map[string]*meta.Index{}
+	for _, idx := range articles.Indexes() {
+		idxs[idx.Name] = idx
+	}
+	require.Contains(t, idxs, "PRIMARY")
+	assert.True(t, idxs["PRIMARY"].Primary)
+	assert.True(t, idxs["PRIMARY"].Unique)
+	require.Contains(t, idxs, "ix_title")
+	assert.Equal(t, []string{"title"}, idxs["ix_title"].Columns)
+	assert.Equal(t, []string{""}, idxs["ix_title"].Expressions)
+	assert.False(t, idxs["ix_title"].Unique)
+	require.Contains(t, idxs, "ix_lower_title")
+	lower := idxs["ix_lower_title"]
+	assert.True(t, lower.Expression)
+	assert.Equal(t, []string{""}, lower.Columns)
+	require.Len(t, lower.Expressions, 1)
+	assert.Contains(t, strings.ToLower(lower.Expressions[0]), "lower(")
+
+	published := m.T("published_articles")
+	require.NotNil(t, published)
+	assert.Equal(t, meta.TableKindView, published.Kind())
+	assert.True(t, published.Updatable())
+	assert.NotNil(t, published.C("title"))
+
+	counts := m.T("article_counts")
+	require.NotNil(t, counts)
+	assert.Equal(t, meta.TableKindView, counts.Kind())
+	assert.False(t, counts.Updatable())
+	assert.NotNil(t, counts.C("num_articles"))
+	_, err = sqlb.DeleteE(counts)
+	assert.ErrorIs(t, err, sqlb.ReadOnlyRelation)
+}
+
+func TestReflectMySQLSchemas(t *testing.T) {
+	skipIfNoMySQL(t)
+	db, err := sql.Open("mysql", getMySQLDSN())
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := sqlb.Reflect(db, sqlb.WithSchemas("sqlbtest", "billing"))
+	require.Nil(t, err)
+	require.NotNil(t, m)
+	// Tables in the connection's database are still available directly
+	assert.Equal(t, 5, len(m.Tables))
+	users := m.Schema("sqlbtest").T("users")
+	require.NotNil(t, users)
+	assert.Same(t, users, m.T("users"))
+
+	invoices := m.Schema("billing").T("invoices")
+	require.NotNil(t, invoices)
+	assert.Nil(t, m.T("invoices"))
+	assert.Equal(t, "billing", invoices.Schema())
+	require.Len(t, invoices.ForeignKeys(), 1)
+	assert.True(t, invoices.ForeignKeys()[0].References(users))
+
+	sel := sqlb.Select(invoices.C("amount"), users.C("name"))
+	sel = sel.JoinFK(users)
+	b := builder.New(sqlb.WithDialect(sqlb.MySQL))
+	qs, _ := b.StringArgs(sel.Query())
+	assert.Equal(
+		t,
+		"SELECT invoices.amount, users.name FROM billing.invoices "+
+			"JOIN sqlbtest.users ON invoices.user_id = users.id",
+		qs,
+	)
 }
 
 func TestReflectPostgreSQL(t *testing.T) {
@@ -93,16 +186,16 @@ func TestReflectPostgreSQL(t *testing.T) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	meta, err := sqlb.Reflect(db)
+	m, err := sqlb.Reflect(db)
 	if err != nil {
 		log.Fatal(err)
 	}
 	require.Nil(t, err)
-	require.NotNil(t, meta)
-	assert.Equal(t, 3, len(meta.Tables))
-	assert.Equal(t, "sqlbtest", meta.Name)
+	require.NotNil(t, m)
+	assert.Equal(t, 5, len(m.Tables))
+	assert.Equal(t, "sqlbtest", m.Name)
 
-	users := meta.T("users")
+	users := m.T("users")
 	require.NotNil(t, users)
 	userCols := users.Projections()
 	assert.Equal(t, 3, len(userCols))
@@ -111,4 +204,90 @@ func TestReflectPostgreSQL(t *testing.T) {
 	require.NotNil(t, userID)
 	assert.Equal(t, "id", userID.Name())
 	assert.Equal(t, users, userID.References())
+
+	col := userID.(*meta.Column)
+	assert.Equal(t, "integer", col.DataType())
+	assert.Equal(t, int64(32), col.Precision())
+	assert.False(t, col.Nullable())
+
+	col = users.C("name").(*meta.Column)
+	assert.Equal(t, "character varying", col.DataType())
+	assert.Equal(t, int64(100), col.Length())
+
+	pk := users.PrimaryKey()
+	require.NotNil(t, pk)
+	assert.Equal(t, []string{"id"}, pk.Columns)
+	// ix_name is a unique index, not a unique constraint, in PostgreSQL
+	assert.Empty(t, users.UniqueConstraints())
+
+	articles := m.T("articles")
+	require.NotNil(t, articles)
+	require.Len(t, articles.ForeignKeys(), 1)
+	fk := articles.ForeignKeys()[0]
+	assert.Equal(t, []string{"author"}, fk.Columns)
+	assert.Equal(t, "users", fk.ReferencedTable)
+	assert.Equal(t, []string{"id"}, fk.ReferencedColumns)
+
+	idxs := map[string]*meta.Index{}
+	for _, idx := range articles.Indexes() {
+		idxs[idx.Name] = idx
+	}
+	require.Contains(t, idxs, "articles_pkey")
+	assert.True(t, idxs["articles_pkey"].Primary)
+	assert.True(t, idxs["articles_pkey"].Unique)
+	require.Contains(t, idxs, "ix_title")
+	assert.Equal(t, []string{"title"}, idxs["ix_title"].Columns)
+	assert.Equal(t, []string{""}, idxs["ix_title"].Expressions)
+	assert.False(t, idxs["ix_title"].Unique)
+	require.Contains(t, idxs, "ix_lower_title")
+	lower := idxs["ix_lower_title"]
+	assert.True(t, lower.Expression)
+	assert.Equal(t, []string{""}, lower.Columns)
+	require.Len(t, lower.Expressions, 1)
+	assert.Contains(t, strings.ToLower(lower.Expressions[0]), "lower(")
+
+	published := m.T("published_articles")
+	require.NotNil(t, published)
+	assert.Equal(t, meta.TableKindView, published.Kind())
+	assert.True(t, published.Updatable())
+	assert.NotNil(t, published.C("title"))
+
+	counts := m.T("article_counts")
+	require.NotNil(t, counts)
+	assert.Equal(t, meta.TableKindMaterializedView, counts.Kind())
+	assert.False(t, counts.Updatable())
+	assert.NotNil(t, counts.C("num_articles"))
+	_, err = sqlb.DeleteE(counts)
+	assert.ErrorIs(t, err, sqlb.ReadOnlyRelation)
+}
+
+func TestReflectPostgreSQLSchemas(t *testing.T) {
+	skipIfNoPostgreSQL(t)
+	db, err := sql.Open("postgres", getPostgreSQLDSN())
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := sqlb.Reflect(db, sqlb.WithAllSchemas())
+	require.Nil(t, err)
+	require.NotNil(t, m)
+	// Tables in the public schema are still available directly
+	assert.Equal(t, 5, len(m.Tables))
+	assert.Nil(t, m.Schema("pg_catalog"))
+	assert.Nil(t, m.Schema("information_schema"))
+	users := m.Schema("public").T("users")
+	require.NotNil(t, users)
+	assert.Same(t, users, m.T("users"))
+
+	invoices := m.Schema("billing").T("invoices")
+	require.NotNil(t, invoices)
+	assert.Equal(t, "billing", invoices.Schema())
+	assert.Len(t, invoices.Projections(), 3)
+	require.Len(t, invoices.ForeignKeys(), 1)
+	fk := invoices.ForeignKeys()[0]
+	assert.Equal(t, "public", fk.ReferencedSchema)
+	assert.True(t, fk.References(users))
+
+	b := builder.New(sqlb.WithDialect(sqlb.PostgreSQL))
+	qs, _ := b.StringArgs(sqlb.Select(invoices.C("id")).Query())
+	assert.Equal(t, "SELECT invoices.id FROM billing.invoices", qs)
 }
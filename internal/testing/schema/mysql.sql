@@ -36,6 +36,7 @@ CREATE TABLE articles (
 , created_on DATETIME NOT NULL
 , PRIMARY KEY (id)
 , INDEX ix_title (title)
+, INDEX ix_lower_title ((LOWER(title)))
 , FOREIGN KEY fk_users (author) REFERENCES users (id)
 );
 
@@ -44,3 +45,23 @@ INSERT INTO articles (id, title, author, state, created_on) VALUES
 , (2, "Bob's list of accomplishments", 2, 1, "2018-06-19")
 , (3, "Charlie's list of statements", 3, 3, "2019-12-13")
 ;
+
+CREATE VIEW published_articles AS
+SELECT id, title, author
+FROM articles
+WHERE state = 2;
+
+CREATE VIEW article_counts AS
+SELECT author, COUNT(*) AS num_articles
+FROM articles
+GROUP BY author;
+
+CREATE DATABASE IF NOT EXISTS billing;
+
+CREATE TABLE billing.invoices (
+  id INT NOT NULL
+, user_id INT NOT NULL
+, amount DECIMAL(10, 2) NOT NULL
+, PRIMARY KEY (id)
+, CONSTRAINT fk_invoices_users FOREIGN KEY (user_id) REFERENCES ${dbname}.users (id)
+);
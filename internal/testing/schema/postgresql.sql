@@ -35,9 +35,30 @@ CREATE TABLE articles (
 );
 
 CREATE INDEX ix_title ON articles (title);
+CREATE INDEX ix_lower_title ON articles (LOWER(title));
 
 INSERT INTO articles (id, title, author, state, created_on) VALUES
   (1, 'Alice''s list of grievances', 1, 2, '2018-01-19')
 , (2, 'Bob''s list of accomplishments', 2, 1, '2018-06-19')
 , (3, 'Charlie''s list of statements', 3, 3, '2019-12-13')
 ;
+
+CREATE VIEW published_articles AS
+SELECT id, title, author
+FROM articles
+WHERE state = 2;
+
+CREATE MATERIALIZED VIEW article_counts AS
+SELECT author, COUNT(*) AS num_articles
+FROM articles
+GROUP BY author;
+
+CREATE SCHEMA billing;
+
+CREATE TABLE billing.invoices (
+  id INT NOT NULL
+, user_id INT NOT NULL
+, amount DECIMAL(10, 2) NOT NULL
+, PRIMARY KEY (id)
+, CONSTRAINT fk_invoices_users FOREIGN KEY (user_id) REFERENCES public.users (id)
+);
@@ -0,0 +1,224 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package testing_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jaypipes/sqlb"
+	"github.com/jaypipes/sqlb/core/meta"
+	"github.com/jaypipes/sqlb/core/reflect"
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+// openSQLite returns a handle to a new on-disk SQLite database in a temporary
+// directory, loaded with the testing schema
+func openSQLite(t *testing.T) *sql.DB {
+	dir := t.TempDir()
+	db, err := sql.Open("sqlite", filepath.Join(dir, "sqlbtest.db"))
+	require.Nil(t, err)
+	t.Cleanup(func() { db.Close() })
+	// ATTACH only applies to a single connection
+	db.SetMaxOpenConns(1)
+
+	schema, err := os.ReadFile(filepath.Join("schema", "sqlite.sql"))
+	require.Nil(t, err)
+	for _, stmt := range strings.Split(string(schema), ";\n") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		_, err = db.Exec(stmt)
+		require.Nil(t, err, stmt)
+	}
+	return db
+}
+
+func TestReflectSQLite(t *testing.T) {
+	db := openSQLite(t)
+	assert.Equal(t, sqlb.SQLite, reflect.Dialect(db))
+
+	m, err := sqlb.Reflect(db)
+	require.Nil(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, "main", m.Name)
+	assert.NotEmpty(t, m.Version)
+	assert.Equal(t, 4, len(m.Tables))
+	require.NotNil(t, m.Schema("main"))
+
+	users := m.T("users")
+	require.NotNil(t, users)
+	assert.Equal(t, 3, len(users.Projections()))
+
+	col := users.C("id").(*meta.Column)
+	assert.Equal(t, "integer", col.DataType())
+	assert.False(t, col.Nullable())
+	assert.True(t, col.AutoIncrement())
+
+	col = users.C("name").(*meta.Column)
+	assert.Equal(t, "varchar", col.DataType())
+	assert.Equal(t, int64(100), col.Length())
+
+	pk := users.PrimaryKey()
+	require.NotNil(t, pk)
+	assert.Equal(t, []string{"id"}, pk.Columns)
+
+	states := m.T("article_states")
+	require.NotNil(t, states)
+	require.Len(t, states.UniqueConstraints(), 1)
+	assert.Equal(t, []string{"name"}, states.UniqueConstraints()[0].Columns)
+
+	articles := m.T("articles")
+	require.NotNil(t, articles)
+	require.Len(t, articles.ForeignKeys(), 2)
+	fks := map[string]*meta.ForeignKey{}
+	for _, fk := range articles.ForeignKeys() {
+		fks[fk.ReferencedTable] = fk
+	}
+	require.Contains(t, fks, "users")
+	assert.Equal(t, []string{"author"}, fks["users"].Columns)
+	// The referenced columns are omitted in the schema and default to the
+	// referenced table's primary key
+	assert.Equal(t, []string{"id"}, fks["users"].ReferencedColumns)
+	require.Contains(t, fks, "article_states")
+	assert.Equal(t, []string{"id"}, fks["article_states"].ReferencedColumns)
+
+	idxs := map[string]*meta.Index{}
+	for _, idx := range users.Indexes() {
+		idxs[idx.Name] = idx
+	}
+	require.Contains(t, idxs, "ix_name")
+	assert.True(t, idxs["ix_name"].Unique)
+	assert.Equal(t, []string{"name"}, idxs["ix_name"].Columns)
+
+	published := m.T("published_articles")
+	require.NotNil(t, published)
+	assert.Equal(t, meta.TableKindView, published.Kind())
+	assert.False(t, published.Updatable())
+	assert.NotNil(t, published.C("title"))
+}
+
+func TestReflectSQLiteSchemas(t *testing.T) {
+	db := openSQLite(t)
+	billing := filepath.Join(t.TempDir(), "billing.db")
+	_, err := db.Exec("ATTACH DATABASE ? AS billing", billing)
+	require.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE billing.invoices (
+  id INTEGER NOT NULL PRIMARY KEY
+, user_id INT NOT NULL
+, amount DECIMAL(10, 2) NOT NULL
+)`)
+	require.Nil(t, err)
+
+	m, err := sqlb.Reflect(db, sqlb.WithAllSchemas())
+	require.Nil(t, err)
+	assert.Equal(t, 4, len(m.Tables))
+	assert.Same(t, m.T("users"), m.Schema("main").T("users"))
+
+	invoices := m.Schema("billing").T("invoices")
+	require.NotNil(t, invoices)
+	assert.Equal(t, "billing", invoices.Schema())
+	col := invoices.C("amount").(*meta.Column)
+	assert.Equal(t, "decimal", col.DataType())
+	assert.Equal(t, int64(10), col.Precision())
+	assert.Equal(t, int64(2), col.Scale())
+
+	_, err = sqlb.Exec(
+		db, sqlb.Insert(invoices, map[string]interface{}{
+			"id": 1, "user_id": 1, "amount": 9.5,
+		}), sqlb.WithDialect(sqlb.SQLite),
+	)
+	require.Nil(t, err)
+	var total float64
+	row, err := sqlb.QueryRow(
+		db, sqlb.Select(sqlb.Sum(invoices.C("amount"))),
+		sqlb.WithDialect(sqlb.SQLite),
+	)
+	require.Nil(t, err)
+	require.Nil(t, row.Scan(&total))
+	assert.Equal(t, 9.5, total)
+}
+
+func TestSQLiteQueries(t *testing.T) {
+	ctx := context.TODO()
+	db := openSQLite(t)
+	m, err := sqlb.Reflect(db)
+	require.Nil(t, err)
+	users := m.T("users")
+	articles := m.T("articles")
+
+	type userArticle struct {
+		Name  string
+		Title sql.NullString
+	}
+
+	// Concatenation uses the || operator
+	sel := sqlb.Select(
+		sqlb.Concat(users.C("name"), "!").As("name"),
+		articles.C("title"),
+	).Join(
+		articles, sqlb.Equal(articles.C("author"), users.C("id")),
+	).Where(sqlb.Equal(users.C("id"), 1))
+	got, err := sqlb.One[userArticle](ctx, db, sel, sqlb.WithDialect(sqlb.SQLite))
+	require.Nil(t, err)
+	assert.Equal(t, "Alice!", got.Name)
+
+	// RIGHT JOIN is output natively or, for SQLite versions before 3.39, as
+	// a LEFT JOIN with swapped operands. Both must return the user without
+	// any articles.
+	for _, version := range []string{m.Version, "3.38.0"} {
+		sel := sqlb.Select(users.C("name"), articles.C("title")).RightJoin(
+			users, sqlb.Equal(articles.C("author"), users.C("id")),
+		)
+		sel.OrderBy(users.C("id").Asc())
+		opts := []types.Option{
+			sqlb.WithDialect(sqlb.SQLite), sqlb.WithDialectVersion(version),
+		}
+		all, err := sqlb.All[userArticle](ctx, db, sel, opts...)
+		require.Nil(t, err, version)
+		require.Len(t, all, 4, version)
+		assert.Equal(t, "Dan", all[3].Name, version)
+		assert.False(t, all[3].Title.Valid, version)
+	}
+
+	// UPDATE with ORDER BY and LIMIT is output using a rowid subquery
+	upd := sqlb.Update(
+		articles, map[string]interface{}{"state": 3},
+	).OrderBy(articles.C("id").Desc()).Limit(1)
+	res, err := sqlb.Exec(db, upd, sqlb.WithDialect(sqlb.SQLite))
+	require.Nil(t, err)
+	n, err := res.RowsAffected()
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), n)
+
+	// TRUNCATE is output as an unqualified DELETE
+	_, err = sqlb.Exec(
+		db, articles.Truncate(), sqlb.WithDialect(sqlb.SQLite),
+	)
+	require.Nil(t, err)
+	var count int
+	row, err := sqlb.QueryRow(
+		db, sqlb.Select(articles.Count()), sqlb.WithDialect(sqlb.SQLite),
+	)
+	require.Nil(t, err)
+	require.Nil(t, row.Scan(&count))
+	assert.Equal(t, 0, count)
+
+	// The builder output is checked against the real SQLite grammar
+	b := builder.New(sqlb.WithDialect(sqlb.SQLite))
+	qs, _ := b.StringArgs(sqlb.Select(sqlb.Concat(users.C("name"), "x")).Query())
+	_, err = db.Prepare(qs)
+	assert.Nil(t, err, qs)
+}
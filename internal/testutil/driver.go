@@ -0,0 +1,135 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sync"
+)
+
+// FakeResult is the canned result returned by a FakeDriver for every query
+type FakeResult struct {
+	Columns []string
+	Rows    [][]driver.Value
+}
+
+// FakeDriver is a `database/sql/driver` implementation that records the SQL
+// statements it is asked to prepare and execute and returns a canned result
+// for every query.
+type FakeDriver struct {
+	sync.Mutex
+	Result FakeResult
+	// Prepared contains the SQL strings that were prepared, in order
+	Prepared []string
+	// Executed contains the SQL strings that were executed, in order
+	Executed []string
+	// Closed contains the SQL strings of the prepared statements that were
+	// closed, in order
+	Closed []string
+}
+
+// NewFakeDB returns a `*sql.DB` backed by the supplied FakeDriver
+func NewFakeDB(d *FakeDriver) *sql.DB {
+	return sql.OpenDB(d)
+}
+
+// Connect implements driver.Connector
+func (d *FakeDriver) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+// Driver implements driver.Connector
+func (d *FakeDriver) Driver() driver.Driver {
+	return d
+}
+
+// Open implements driver.Driver
+func (d *FakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct {
+	d *FakeDriver
+}
+
+func (c *fakeConn) Prepare(qs string) (driver.Stmt, error) {
+	c.d.Lock()
+	defer c.d.Unlock()
+	c.d.Prepared = append(c.d.Prepared, qs)
+	return &fakeStmt{d: c.d, qs: qs}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	return nil
+}
+
+type fakeStmt struct {
+	d  *FakeDriver
+	qs string
+}
+
+func (s *fakeStmt) Close() error {
+	s.d.Lock()
+	defer s.d.Unlock()
+	s.d.Closed = append(s.d.Closed, s.qs)
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	s.d.Lock()
+	defer s.d.Unlock()
+	s.d.Executed = append(s.d.Executed, s.qs)
+	return driver.RowsAffected(len(s.d.Result.Rows)), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.Lock()
+	defer s.d.Unlock()
+	s.d.Executed = append(s.d.Executed, s.qs)
+	return &fakeRows{res: s.d.Result}, nil
+}
+
+type fakeRows struct {
+	res FakeResult
+	cur int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.res.Columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.cur >= len(r.res.Rows) {
+		return io.EOF
+	}
+	copy(dest, r.res.Rows[r.cur])
+	r.cur++
+	return nil
+}
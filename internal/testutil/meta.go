@@ -73,6 +73,38 @@ func init() {
 		"user_id",
 	)
 
+	users.SetPrimaryKey("PRIMARY", "id")
+	articles.SetPrimaryKey("PRIMARY", "id")
+	articles.AddForeignKey(&meta.ForeignKey{
+		Name:              "fk_articles_users",
+		Columns:           []string{"author"},
+		ReferencedTable:   "users",
+		ReferencedColumns: []string{"id"},
+	})
+	articles.AddForeignKey(&meta.ForeignKey{
+		Name:              "fk_articles_article_states",
+		Columns:           []string{"state"},
+		ReferencedTable:   "article_states",
+		ReferencedColumns: []string{"id"},
+	})
+	articleStates.SetPrimaryKey("PRIMARY", "id")
+	articleStates.AddUniqueConstraint("uix_name", "name")
+	organizations.SetPrimaryKey("PRIMARY", "id")
+	organizations.AddUniqueConstraint("uix_uuid", "uuid")
+	organizationUsers.SetPrimaryKey("PRIMARY", "organization_id", "user_id")
+	organizationUsers.AddForeignKey(&meta.ForeignKey{
+		Name:              "fk_organization_users_organizations",
+		Columns:           []string{"organization_id"},
+		ReferencedTable:   "organizations",
+		ReferencedColumns: []string{"id"},
+	})
+	organizationUsers.AddForeignKey(&meta.ForeignKey{
+		Name:              "fk_organization_users_users",
+		Columns:           []string{"user_id"},
+		ReferencedTable:   "users",
+		ReferencedColumns: []string{"id"},
+	})
+
 	m.Tables = map[string]*meta.Table{
 		"users":              users,
 		"articles":           articles,
@@ -0,0 +1,344 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package sqlb
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/jaypipes/sqlb/core/types"
+	"github.com/jaypipes/sqlb/internal/builder"
+)
+
+// DefaultStmtCacheSize is the maximum number of prepared statements held by a
+// StmtCache created with a size less than 1
+const DefaultStmtCacheSize = 100
+
+// StmtCache is a cache of prepared statements for a `*sql.DB`, keyed by the
+// SQL string produced by the queryable or executable object it is asked to
+// run. Objects that produce the same SQL string, regardless of their query
+// args, share a single prepared statement.
+//
+// The cache holds at most a fixed number of prepared statements. When it is
+// full, the least recently used statement is evicted and closed once no
+// longer in use.
+//
+// A StmtCache is safe for concurrent use by multiple goroutines.
+type StmtCache struct {
+	db   *sql.DB
+	opts []types.Option
+	size int
+
+	mu sync.Mutex
+	// lru holds the cached *stmtCacheEntry, most recently used first
+	lru     *list.List
+	entries map[string]*list.Element
+	stats   StmtCacheStats
+}
+
+// StmtCacheStats contains the metrics of a StmtCache
+type StmtCacheStats struct {
+	// Hits is the number of times a cached prepared statement was used
+	Hits uint64
+	// Misses is the number of times a statement had to be prepared because
+	// it was not in the cache
+	Misses uint64
+	// Evictions is the number of prepared statements evicted from the cache
+	Evictions uint64
+	// Size is the number of prepared statements currently in the cache
+	Size int
+}
+
+// stmtCacheEntry is a prepared statement in a StmtCache
+type stmtCacheEntry struct {
+	qs   string
+	stmt *sql.Stmt
+	// refs is the number of callers currently using the statement. An
+	// evicted statement is only closed once refs drops to zero.
+	refs    int
+	evicted bool
+}
+
+// NewStmtCache returns a StmtCache that prepares statements on the supplied
+// database handle and holds at most size prepared statements. The supplied
+// Options, e.g. WithDialect, are used to build the SQL string of every object
+// run through the cache.
+func NewStmtCache(
+	db *sql.DB,
+	size int,
+	opts ...types.Option,
+) *StmtCache {
+	if size < 1 {
+		size = DefaultStmtCacheSize
+	}
+	return &StmtCache{
+		db:      db,
+		opts:    opts,
+		size:    size,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// Stats returns the StmtCache's hit, miss and eviction counts and its current
+// size
+func (c *StmtCache) Stats() StmtCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Size = c.lru.Len()
+	return stats
+}
+
+// Close closes and removes all prepared statements in the StmtCache. Any
+// statement still in use is closed once no longer in use.
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	var closing []*sql.Stmt
+	for c.lru.Len() > 0 {
+		if stmt := c.evict(c.lru.Back()); stmt != nil {
+			closing = append(closing, stmt)
+		}
+	}
+	c.mu.Unlock()
+	var err error
+	for _, stmt := range closing {
+		if cerr := stmt.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// ExecContext builds the supplied executable object (returned from Insert(),
+// Update(), Delete() or one of the `meta.Table` statement methods) and
+// executes the cached prepared statement for its SQL string with its query
+// args.
+func (c *StmtCache) ExecContext(
+	ctx context.Context,
+	target interface{},
+) (sql.Result, error) {
+	return c.exec(ctx, nil, target)
+}
+
+// QueryContext builds the supplied queryable object (returned from Select(),
+// Insert(), Update(), or Delete()) and runs the cached prepared statement for
+// its SQL string with its query args.
+func (c *StmtCache) QueryContext(
+	ctx context.Context,
+	target interface{},
+) (*sql.Rows, error) {
+	return c.query(ctx, nil, target)
+}
+
+// QueryRowContext builds the supplied queryable object (returned from
+// Select(), Insert(), Update(), or Delete()) and runs the cached prepared
+// statement for its SQL string with its query args, returning at most one
+// row.
+//
+// Since a `*sql.Row` cannot be constructed with an error, QueryRowContext
+// returns an error, and no row, if the queryable object cannot be built or
+// the statement cannot be prepared. Errors executing the query are returned
+// by the row's Scan method.
+func (c *StmtCache) QueryRowContext(
+	ctx context.Context,
+	target interface{},
+) (*sql.Row, error) {
+	return c.queryRow(ctx, nil, target)
+}
+
+// Tx returns a StmtCacheTx that runs the StmtCache's prepared statements
+// within the supplied transaction
+func (c *StmtCache) Tx(tx *sql.Tx) *StmtCacheTx {
+	return &StmtCacheTx{c: c, tx: tx}
+}
+
+// StmtCacheTx runs the prepared statements of a StmtCache within a
+// transaction. Each cached statement is rebound to the transaction using
+// `sql.Tx.StmtContext`, and the transaction-specific statement is closed when
+// the transaction is committed or rolled back.
+//
+// A statement that is not yet in the cache is prepared on the StmtCache's
+// `*sql.DB`, which requires a connection from the pool in addition to the
+// one held by the transaction.
+type StmtCacheTx struct {
+	c  *StmtCache
+	tx *sql.Tx
+}
+
+// ExecContext builds the supplied executable object and executes the cached
+// prepared statement for its SQL string within the transaction
+func (t *StmtCacheTx) ExecContext(
+	ctx context.Context,
+	target interface{},
+) (sql.Result, error) {
+	return t.c.exec(ctx, t.tx, target)
+}
+
+// QueryContext builds the supplied queryable object and runs the cached
+// prepared statement for its SQL string within the transaction
+func (t *StmtCacheTx) QueryContext(
+	ctx context.Context,
+	target interface{},
+) (*sql.Rows, error) {
+	return t.c.query(ctx, t.tx, target)
+}
+
+// QueryRowContext builds the supplied queryable object and runs the cached
+// prepared statement for its SQL string within the transaction, returning at
+// most one row. It returns an error under the same conditions as
+// StmtCache.QueryRowContext.
+func (t *StmtCacheTx) QueryRowContext(
+	ctx context.Context,
+	target interface{},
+) (*sql.Row, error) {
+	return t.c.queryRow(ctx, t.tx, target)
+}
+
+func (c *StmtCache) exec(
+	ctx context.Context,
+	tx *sql.Tx,
+	target interface{},
+) (sql.Result, error) {
+	stmt, qargs, release, err := c.stmt(ctx, tx, target)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return stmt.ExecContext(ctx, qargs...)
+}
+
+func (c *StmtCache) query(
+	ctx context.Context,
+	tx *sql.Tx,
+	target interface{},
+) (*sql.Rows, error) {
+	stmt, qargs, release, err := c.stmt(ctx, tx, target)
+	if err != nil {
+		return nil, err
+	}
+	// The returned rows keep the statement open until the rows are closed,
+	// so the statement may be released as soon as the query has started.
+	defer release()
+	return stmt.QueryContext(ctx, qargs...)
+}
+
+func (c *StmtCache) queryRow(
+	ctx context.Context,
+	tx *sql.Tx,
+	target interface{},
+) (*sql.Row, error) {
+	stmt, qargs, release, err := c.stmt(ctx, tx, target)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return stmt.QueryRowContext(ctx, qargs...), nil
+}
+
+// stmt builds the supplied target and returns the prepared statement for its
+// SQL string, rebound to the supplied transaction if not nil, along with the
+// target's query args and a function that must be called once the caller is
+// done with the statement
+func (c *StmtCache) stmt(
+	ctx context.Context,
+	tx *sql.Tx,
+	target interface{},
+) (*sql.Stmt, []interface{}, func(), error) {
+	b := builder.Acquire(c.opts...)
+	qs, qargs, err := b.Build(target)
+	b.Release()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	entry, err := c.acquire(ctx, qs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	release := func() { c.release(entry) }
+	if tx == nil {
+		return entry.stmt, qargs, release, nil
+	}
+	return tx.StmtContext(ctx, entry.stmt), qargs, release, nil
+}
+
+// acquire returns the cache entry for the supplied SQL string, preparing the
+// statement if it is not cached, and marks the entry as in use
+func (c *StmtCache) acquire(
+	ctx context.Context,
+	qs string,
+) (*stmtCacheEntry, error) {
+	c.mu.Lock()
+	if el, found := c.entries[qs]; found {
+		c.stats.Hits++
+		c.lru.MoveToFront(el)
+		entry := el.Value.(*stmtCacheEntry)
+		entry.refs++
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.stats.Misses++
+	c.mu.Unlock()
+
+	// We don't hold the lock while preparing the statement, since that
+	// requires a round trip to the database
+	stmt, err := c.db.PrepareContext(ctx, qs)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if el, found := c.entries[qs]; found {
+		// Another goroutine prepared the same statement in the meantime
+		entry := el.Value.(*stmtCacheEntry)
+		entry.refs++
+		c.mu.Unlock()
+		stmt.Close()
+		return entry, nil
+	}
+	entry := &stmtCacheEntry{qs: qs, stmt: stmt, refs: 1}
+	c.entries[qs] = c.lru.PushFront(entry)
+	var closing []*sql.Stmt
+	for c.lru.Len() > c.size {
+		if evicted := c.evict(c.lru.Back()); evicted != nil {
+			closing = append(closing, evicted)
+		}
+		c.stats.Evictions++
+	}
+	c.mu.Unlock()
+	for _, evicted := range closing {
+		evicted.Close()
+	}
+	return entry, nil
+}
+
+// release marks the supplied entry as no longer in use by the caller,
+// closing its statement if it has been evicted and is not in use by anyone
+// else
+func (c *StmtCache) release(entry *stmtCacheEntry) {
+	c.mu.Lock()
+	entry.refs--
+	closing := entry.evicted && entry.refs == 0
+	c.mu.Unlock()
+	if closing {
+		entry.stmt.Close()
+	}
+}
+
+// evict removes the supplied element from the cache and returns its
+// statement if it can be closed immediately. The caller must hold the lock.
+func (c *StmtCache) evict(el *list.Element) *sql.Stmt {
+	entry := c.lru.Remove(el).(*stmtCacheEntry)
+	delete(c.entries, entry.qs)
+	entry.evicted = true
+	if entry.refs > 0 {
+		return nil
+	}
+	return entry.stmt
+}
@@ -0,0 +1,127 @@
+//
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package sqlb_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/jaypipes/sqlb"
+	"github.com/jaypipes/sqlb/core/expr"
+	"github.com/jaypipes/sqlb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmtCache(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	users := testutil.T("users")
+	colUserId := users.C("id")
+	colUserName := users.C("name")
+
+	d := &testutil.FakeDriver{
+		Result: testutil.FakeResult{
+			Columns: []string{"name"},
+			Rows:    [][]driver.Value{{"foo"}},
+		},
+	}
+	db := testutil.NewFakeDB(d)
+	db.SetMaxOpenConns(1)
+	c := sqlb.NewStmtCache(db, 2)
+
+	byId := func(id int) *expr.Selection {
+		return sqlb.Select(colUserName).Where(sqlb.Equal(colUserId, id))
+	}
+	qsById := "SELECT users.name FROM users WHERE users.id = ?"
+	qsUpdate := "UPDATE users SET name = ? WHERE users.id = ?"
+	qsInsert := "INSERT INTO users (id) VALUES (?)"
+
+	// The same query shape with different args shares a prepared statement
+	for x := 0; x < 3; x++ {
+		rows, err := c.QueryContext(ctx, byId(x))
+		require.Nil(t, err)
+		require.Nil(t, rows.Close())
+	}
+	var name string
+	row, err := c.QueryRowContext(ctx, byId(4))
+	require.Nil(t, err)
+	require.Nil(t, row.Scan(&name))
+	assert.Equal("foo", name)
+	assert.Equal([]string{qsById}, d.Prepared)
+	assert.Equal(
+		sqlb.StmtCacheStats{Hits: 3, Misses: 1, Size: 1},
+		c.Stats(),
+	)
+
+	_, err = c.ExecContext(
+		ctx,
+		sqlb.Update(users, map[string]any{"name": "bar"}).
+			Where(sqlb.Equal(colUserId, 1)),
+	)
+	require.Nil(t, err)
+	assert.Equal([]string{qsById, qsUpdate}, d.Prepared)
+
+	// Using the SELECT makes the UPDATE the least recently used statement,
+	// which is evicted and closed when a third statement is prepared
+	rows, err := c.QueryContext(ctx, byId(5))
+	require.Nil(t, err)
+	require.Nil(t, rows.Close())
+	_, err = c.ExecContext(ctx, sqlb.Insert(users, map[string]any{"id": 1}))
+	require.Nil(t, err)
+	assert.Equal([]string{qsById, qsUpdate, qsInsert}, d.Prepared)
+	assert.Equal([]string{qsUpdate}, d.Closed)
+	assert.Equal(
+		sqlb.StmtCacheStats{Hits: 4, Misses: 3, Evictions: 1, Size: 2},
+		c.Stats(),
+	)
+
+	// Build errors are returned without preparing anything
+	_, err = c.QueryContext(ctx, "SELECT 1")
+	assert.ErrorIs(err, sqlb.UnsupportedBuildTarget)
+	row, err = c.QueryRowContext(ctx, "SELECT 1")
+	assert.ErrorIs(err, sqlb.UnsupportedBuildTarget)
+	assert.Nil(row)
+
+	require.Nil(t, c.Close())
+	assert.ElementsMatch([]string{qsUpdate, qsById, qsInsert}, d.Closed)
+	assert.Equal(0, c.Stats().Size)
+}
+
+func TestStmtCacheTx(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	users := testutil.T("users")
+	colUserId := users.C("id")
+
+	d := &testutil.FakeDriver{}
+	db := testutil.NewFakeDB(d)
+	c := sqlb.NewStmtCache(db, 0, sqlb.WithDialect(sqlb.PostgreSQL))
+	qs := "DELETE FROM users WHERE users.id = $1"
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.Nil(t, err)
+	for x := 0; x < 2; x++ {
+		_, err = c.Tx(tx).ExecContext(
+			ctx, sqlb.Delete(users).Where(sqlb.Equal(colUserId, x)),
+		)
+		require.Nil(t, err)
+	}
+	require.Nil(t, tx.Commit())
+
+	assert.Equal([]string{qs, qs}, d.Executed)
+	assert.Equal(uint64(1), c.Stats().Misses)
+	assert.Equal(uint64(1), c.Stats().Hits)
+
+	// The cached statement is still usable outside of the transaction
+	_, err = c.ExecContext(
+		ctx, sqlb.Delete(users).Where(sqlb.Equal(colUserId, 3)),
+	)
+	require.Nil(t, err)
+	assert.Equal([]string{qs, qs, qs}, d.Executed)
+}